@@ -100,6 +100,7 @@ class DataService {
   Future<void> exportItemsToCsv() async {
     try {
       List<Item> items = _itemService.getAllItems();
+      final attributeKeys = _attributeKeys(items);
       List<List<dynamic>> csvData = [];
       // Add header row
       csvData.add([
@@ -120,6 +121,8 @@ class DataService {
         'SwitchIpAddress',
         'AssignedToID',
         'AssignedToUserName',
+        'Notes',
+        ...attributeKeys.map(_attributeHeader),
       ]);
       // Add item data
       for (var item in items) {
@@ -133,7 +136,7 @@ class DataService {
               ? DateFormat('yyyy-MM-dd').format(item.receivedDate!)
               : '',
           DateFormat('yyyy-MM-dd').format(item.warrantyDate),
-          item.assetStatus.name,
+          item.assetStatus.label,
           item.hostName ?? '',
           item.ipPort ?? '',
           item.macAddress ?? '',
@@ -143,6 +146,8 @@ class DataService {
           item.switchIpAddress ?? '',
           item.assignedTo?.id ?? '',
           item.assignedTo?.userName ?? '',
+          _formatNotes(item),
+          ...attributeKeys.map((key) => _attributeValue(item, key)),
         ]);
       }
       String csv = const ListToCsvConverter().convert(csvData);
@@ -166,6 +171,7 @@ class DataService {
   Future<void> exportItemsToExcel() async {
     try {
       List<Item> items = _itemService.getAllItems();
+      final attributeKeys = _attributeKeys(items);
       var excel = Excel.createExcel();
       Sheet sheetObject = excel['Sheet1'];
       // Add header row
@@ -187,6 +193,8 @@ class DataService {
         'SwitchIpAddress',
         'AssignedToID',
         'AssignedToUserName',
+        'Notes',
+        ...attributeKeys.map(_attributeHeader),
       ];
       sheetObject.insertRowIterables(
           header.map((e) => TextCellValue(e)).toList(), 0);
@@ -203,7 +211,7 @@ class DataService {
               ? DateFormat('yyyy-MM-dd').format(item.receivedDate!)
               : '',
           DateFormat('yyyy-MM-dd').format(item.warrantyDate),
-          item.assetStatus.name,
+          item.assetStatus.label,
           item.hostName ?? '',
           item.ipPort ?? '',
           item.macAddress ?? '',
@@ -213,6 +221,8 @@ class DataService {
           item.switchIpAddress ?? '',
           item.assignedTo?.id ?? '',
           item.assignedTo?.userName ?? '',
+          _formatNotes(item),
+          ...attributeKeys.map((key) => _attributeValue(item, key)),
         ];
         sheetObject.insertRowIterables(
             rowData.map((e) => TextCellValue(e.toString())).toList(), i + 1);
@@ -240,7 +250,35 @@ class DataService {
     }
   }
 
-  // Export template with sample data
+  // One line per note, oldest first
+  String _formatNotes(Item item) {
+    return item.notes
+        .map((note) =>
+            '${DateFormat('yyyy-MM-dd').format(note.createdAt)} '
+            '${note.author.isEmpty ? '' : '${note.author}: '}${note.body}')
+        .join('\n');
+  }
+
+  // Keys of the custom attributes any of the items has, one column each
+  List<String> _attributeKeys(List<Item> items) {
+    final keys = items
+        .expand((item) => item.attributes.map((attribute) => attribute.key))
+        .toSet()
+        .toList();
+    keys.sort();
+    return keys;
+  }
+
+  String _attributeHeader(String key) => 'Attribute: $key';
+
+  String _attributeValue(Item item, String key) {
+    for (final attribute in item.attributes) {
+      if (attribute.key == key) return attribute.value;
+    }
+    return '';
+  }
+
+    // Export template with sample data
   Future<void> exportTemplateCsv() async {
     try {
       List<List<dynamic>> csvData = [];
@@ -589,7 +627,7 @@ class DataService {
       try {
         AssetStatus.values.firstWhere(
               (e) =>
-          e.name.toLowerCase() ==
+          e.label.toLowerCase() ==
               itemMap['AssetStatus'].toString().toLowerCase(),
         );
       } catch (e) {
@@ -598,7 +636,7 @@ class DataService {
           field: 'AssetStatus',
           message:
           'Invalid AssetStatus "${itemMap['AssetStatus']}". Valid values: ${AssetStatus
-              .values.map((e) => e.name).join(", ")}',
+              .values.map((e) => e.label).join(", ")}',
         ));
       }
     }
@@ -707,7 +745,7 @@ class DataService {
 
     AssetStatus assetStatus = AssetStatus.values.firstWhere(
           (e) =>
-      e.name.toLowerCase() == itemMap['AssetStatus'].toString().toLowerCase(),
+      e.label.toLowerCase() == itemMap['AssetStatus'].toString().toLowerCase(),
     );
 
     DateTime? receivedDate;
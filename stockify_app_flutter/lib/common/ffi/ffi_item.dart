@@ -3,7 +3,7 @@ import 'dart:ffi';
 import 'package:ffi/ffi.dart';
 
 // Define the C function signatures for all CRUD operations.
-typedef AddItemFullC = Void Function(
+typedef AddItemFullC = Pointer<Utf8> Function(
   Pointer<Utf8> assetNo,
   Pointer<Utf8> modelNo,
   Pointer<Utf8> deviceType,
@@ -20,7 +20,7 @@ typedef AddItemFullC = Void Function(
   Pointer<Utf8> switchIpAddress,
   Uint64 assignedToID,
 );
-typedef AddItemFullDart = void Function(
+typedef AddItemFullDart = Pointer<Utf8> Function(
   Pointer<Utf8> assetNo,
   Pointer<Utf8> modelNo,
   Pointer<Utf8> deviceType,
@@ -44,7 +44,7 @@ typedef GetAllItemsDart = Pointer<Utf8> Function();
 typedef GetItemByIdC = Pointer<Utf8> Function(Uint64 id);
 typedef GetItemByIdDart = Pointer<Utf8> Function(int id);
 
-typedef UpdateItemFullC = Void Function(
+typedef UpdateItemFullC = Pointer<Utf8> Function(
   Uint64 id,
   Pointer<Utf8> assetNo,
   Pointer<Utf8> modelNo,
@@ -62,7 +62,7 @@ typedef UpdateItemFullC = Void Function(
   Pointer<Utf8> switchIpAddress,
   Uint64 assignedToID,
 );
-typedef UpdateItemFullDart = void Function(
+typedef UpdateItemFullDart = Pointer<Utf8> Function(
   int id,
   Pointer<Utf8> assetNo,
   Pointer<Utf8> modelNo,
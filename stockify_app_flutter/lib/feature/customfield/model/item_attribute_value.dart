@@ -0,0 +1,21 @@
+class ItemAttributeValue {
+  final String key;
+  final String type;
+  final String value;
+
+  ItemAttributeValue(
+      {required this.key, required this.type, required this.value});
+
+  @override
+  String toString() {
+    return 'ItemAttributeValue{key: $key, type: $type, value: $value}';
+  }
+
+  factory ItemAttributeValue.fromJson(Map<String, dynamic> json) {
+    return ItemAttributeValue(
+      key: json['Key'],
+      type: json['Type'] ?? '',
+      value: json['Value'] ?? '',
+    );
+  }
+}
@@ -282,7 +282,7 @@ class _ChartsSectionState extends State<ChartsSection> {
                                         assetStatus:
                                             AssetStatus.values[value.toInt()])),
                                 child: Text(
-                                  AssetStatus.values[value.toInt()].name,
+                                  AssetStatus.values[value.toInt()].label,
                                   style: const TextStyle(
                                     fontSize: 12,
                                     fontWeight: FontWeight.w500,
@@ -344,12 +344,21 @@ class _ChartsSectionState extends State<ChartsSection> {
 
   Color _getAssetStatusColor(AssetStatus status) {
     switch (status) {
+      case AssetStatus.Ordered:
+        return AppColors.colorBlue;
+      case AssetStatus.InStock:
+        return AppColors.colorPurple;
+      case AssetStatus.Deployed:
       case AssetStatus.Active:
         return AppColors.colorGreen;
+      case AssetStatus.InRepair:
       case AssetStatus.Inactive:
         return AppColors.colorOrange;
+      case AssetStatus.Lost:
       case AssetStatus.Disposed:
         return AppColors.colorPink;
+      case AssetStatus.Retired:
+        return AppColors.colorTextSemiLight;
     }
   }
 }
@@ -1 +1,17 @@
-enum AssetStatus { Active, Inactive, Disposed }
+enum AssetStatus {
+  Ordered('Ordered'),
+  InStock('In Stock'),
+  Deployed('Deployed'),
+  InRepair('In Repair'),
+  Lost('Lost'),
+  Retired('Retired'),
+  Disposed('Disposed'),
+  // Statuses from before the lifecycle was introduced, kept for existing items
+  Active('Active'),
+  Inactive('Inactive');
+
+  const AssetStatus(this.label);
+
+  // The status as the backend stores and shows it
+  final String label;
+}
@@ -1,5 +1,7 @@
 import 'package:stockify_app_flutter/feature/item/model/asset_status.dart';
 
+import '../../customfield/model/item_attribute_value.dart';
+import '../../note/model/note.dart';
 import '../../user/model/user.dart';
 import 'device_type.dart';
 
@@ -20,6 +22,8 @@ class Item {
   final String? switchPort;
   final String? switchIpAddress;
   final User? assignedTo;
+  final List<Note> notes;
+  final List<ItemAttributeValue> attributes;
 
   Item(
       {this.id,
@@ -37,7 +41,9 @@ class Item {
       this.facePlateName,
       this.switchPort,
       this.switchIpAddress,
-      this.assignedTo});
+      this.assignedTo,
+      this.notes = const [],
+      this.attributes = const []});
 
   @override
   String toString() {
@@ -67,14 +73,14 @@ class Item {
       assetNo: json['AssetNo'],
       modelNo: json['ModelNo'],
       deviceType: DeviceType.values
-          .firstWhere((e) => e.toString() == json['DeviceType']),
+          .firstWhere((e) => e.name == json['DeviceType']),
       serialNo: json['SerialNo'],
       receivedDate: json['ReceivedDate'] != null
           ? DateTime.parse(json['ReceivedDate']).toLocal()
           : null,
       warrantyDate: DateTime.parse(json['WarrantyDate']).toLocal(),
       assetStatus: AssetStatus.values
-          .firstWhere((e) => e.toString() == json['AssetStatus']),
+          .firstWhere((e) => e.label == json['AssetStatus']),
       hostName: json['HostName'],
       macAddress: json['MacAddress'],
       ipPort: json['IpPort'],
@@ -84,6 +90,12 @@ class Item {
       switchIpAddress: json['SwitchIpAddress'],
       assignedTo:
           json['AssignedTo'] != null ? User.fromJson(json['AssignedTo']) : null,
+      notes: (json['Notes'] as List<dynamic>? ?? [])
+          .map((note) => Note.fromJson(note))
+          .toList(),
+      attributes: (json['Attributes'] as List<dynamic>? ?? [])
+          .map((attribute) => ItemAttributeValue.fromJson(attribute))
+          .toList(),
     );
   }
 }
@@ -28,11 +28,11 @@ class ItemRepository {
   void addItem(Item item) {
     final assetNoPtr = _toUtf8(item.assetNo);
     final modelNoPtr = _toUtf8(item.modelNo);
-    final deviceTypePtr = _toUtf8(item.deviceType.toString());
+    final deviceTypePtr = _toUtf8(item.deviceType.name);
     final serialNoPtr = _toUtf8(item.serialNo);
     final receivedDate = _toUnixTimestamp(item.receivedDate);
     final warrantyDate = _toUnixTimestamp(item.warrantyDate);
-    final assetStatusPtr = _toUtf8(item.assetStatus.toString());
+    final assetStatusPtr = _toUtf8(item.assetStatus.label);
     final hostNamePtr = _toUtf8(item.hostName);
     final ipPortPtr = _toUtf8(item.ipPort);
     final macAddressPtr = _toUtf8(item.macAddress);
@@ -41,7 +41,7 @@ class ItemRepository {
     final switchPortPtr = _toUtf8(item.switchPort);
     final switchIpAddressPtr = _toUtf8(item.switchIpAddress);
     final assignedToID = item.assignedTo?.id ?? 0;
-    _ffi.addItemFull(
+    final resultPtr = _ffi.addItemFull(
       assetNoPtr,
       modelNoPtr,
       deviceTypePtr,
@@ -71,6 +71,13 @@ class ItemRepository {
     if (facePlateNamePtr != nullptr) calloc.free(facePlateNamePtr);
     if (switchPortPtr != nullptr) calloc.free(switchPortPtr);
     if (switchIpAddressPtr != nullptr) calloc.free(switchIpAddressPtr);
+
+    final jsonString = resultPtr.toDartString();
+    _ffi.freeCString(resultPtr);
+    final Map<String, dynamic> result = jsonDecode(jsonString);
+    if (result['error'] != null) {
+      throw Exception(result['error']);
+    }
   }
 
   // Retrieve all items
@@ -96,11 +103,11 @@ class ItemRepository {
     final id = item.id;
     final assetNoPtr = _toUtf8(item.assetNo);
     final modelNoPtr = _toUtf8(item.modelNo);
-    final deviceTypePtr = _toUtf8(item.deviceType.toString());
+    final deviceTypePtr = _toUtf8(item.deviceType.name);
     final serialNoPtr = _toUtf8(item.serialNo);
     final receivedDate = _toUnixTimestamp(item.receivedDate);
     final warrantyDate = _toUnixTimestamp(item.warrantyDate);
-    final assetStatusPtr = _toUtf8(item.assetStatus.toString());
+    final assetStatusPtr = _toUtf8(item.assetStatus.label);
     final hostNamePtr = _toUtf8(item.hostName);
     final ipPortPtr = _toUtf8(item.ipPort);
     final macAddressPtr = _toUtf8(item.macAddress);
@@ -109,7 +116,7 @@ class ItemRepository {
     final switchPortPtr = _toUtf8(item.switchPort);
     final switchIpAddressPtr = _toUtf8(item.switchIpAddress);
     final assignedToID = item.assignedTo?.id != null ? item.assignedTo!.id : 0;
-    _ffi.updateItemFull(
+    final resultPtr = _ffi.updateItemFull(
       id!,
       assetNoPtr,
       modelNoPtr,
@@ -140,6 +147,13 @@ class ItemRepository {
     if (facePlateNamePtr != nullptr) calloc.free(facePlateNamePtr);
     if (switchPortPtr != nullptr) calloc.free(switchPortPtr);
     if (switchIpAddressPtr != nullptr) calloc.free(switchIpAddressPtr);
+
+    final jsonString = resultPtr.toDartString();
+    _ffi.freeCString(resultPtr);
+    final Map<String, dynamic> result = jsonDecode(jsonString);
+    if (result['error'] != null) {
+      throw Exception(result['error']);
+    }
   }
 
   // Delete an item by ID
@@ -149,8 +163,8 @@ class ItemRepository {
 
   List<Item> getFilteredItems(ItemFilterParams params) {
     final searchPtr = _toUtf8(params.search);
-    final deviceTypePtr = _toUtf8(params.deviceType?.toString());
-    final assetStatusPtr = _toUtf8(params.assetStatus?.toString());
+    final deviceTypePtr = _toUtf8(params.deviceType?.name);
+    final assetStatusPtr = _toUtf8(params.assetStatus?.label);
     final warrantyDate = _toUnixTimestamp(params.warrantyDate);
     final warrantyDateFilterTypePtr = _toUtf8(params.warrantyDateFilterType?.name);
     final assignedToID = params.assignedTo?.id ?? 0;
@@ -308,7 +308,7 @@ class _ItemScreenState extends State<ItemScreen> {
                           if (provider.filterParams.assetStatus != null)
                             FilterChipData(
                               label:
-                                  'Asset Status: ${provider.filterParams.assetStatus?.name}',
+                                  'Asset Status: ${provider.filterParams.assetStatus?.label}',
                               onDeleted: () =>
                                   provider.clearAssetStatusFilter(),
                             ),
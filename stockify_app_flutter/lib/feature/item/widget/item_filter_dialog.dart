@@ -158,7 +158,7 @@ class _ItemFilterDialogState extends State<ItemFilterDialog> {
               items: [
                 const DropdownMenuItem(value: null, child: Text('All Status')),
                 ...AssetStatus.values.map((status) =>
-                    DropdownMenuItem(value: status, child: Text(status.name))),
+                    DropdownMenuItem(value: status, child: Text(status.label))),
               ],
               onChanged: (value) {
                 setState(() {
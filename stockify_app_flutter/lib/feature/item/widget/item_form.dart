@@ -625,7 +625,7 @@ class ItemFormState extends State<ItemForm> {
                                 label: 'Asset Status',
                                 value: _selectedAssetStatus,
                                 items: AssetStatus.values,
-                                getDisplayText: (status) => status.name,
+                                getDisplayText: (status) => status.label,
                                 validator:
                                     ItemInputValidator.validateAssetStatus,
                                 onChanged: (value) {
@@ -11,13 +11,21 @@ class ItemStatus extends StatelessWidget {
   @override
   Widget build(BuildContext context) {
     final color = switch (assetStatus) {
-      AssetStatus.Active => AppColors.colorGreen,
-      AssetStatus.Inactive => AppColors.colorOrange,
-      AssetStatus.Disposed => AppColors.colorPink,
+      AssetStatus.Ordered => AppColors.colorBlue,
+      AssetStatus.InStock => AppColors.colorPurple,
+      AssetStatus.Deployed || AssetStatus.Active => AppColors.colorGreen,
+      AssetStatus.InRepair || AssetStatus.Inactive => AppColors.colorOrange,
+      AssetStatus.Lost || AssetStatus.Disposed => AppColors.colorPink,
+      AssetStatus.Retired => AppColors.colorTextSemiLight,
     };
     final icon = switch (assetStatus) {
-      AssetStatus.Active => Icons.check_circle,
+      AssetStatus.Ordered => Icons.local_shipping,
+      AssetStatus.InStock => Icons.inventory_2,
+      AssetStatus.Deployed || AssetStatus.Active => Icons.check_circle,
+      AssetStatus.InRepair => Icons.build_circle,
       AssetStatus.Inactive => Icons.pause_circle,
+      AssetStatus.Lost => Icons.help,
+      AssetStatus.Retired => Icons.archive,
       AssetStatus.Disposed => Icons.remove_circle,
     };
     return Container(
@@ -32,7 +40,7 @@ class ItemStatus extends StatelessWidget {
           Icon(icon, color: color, size: 16),
           const SizedBox(width: 6.0),
           Text(
-            assetStatus.name,
+            assetStatus.label,
             textAlign: TextAlign.center,
             style: TextStyle(color: color, fontWeight: FontWeight.bold),
           ),
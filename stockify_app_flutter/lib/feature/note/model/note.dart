@@ -0,0 +1,26 @@
+class Note {
+  final int id;
+  final String author;
+  final String body;
+  final DateTime createdAt;
+
+  Note(
+      {required this.id,
+      required this.author,
+      required this.body,
+      required this.createdAt});
+
+  @override
+  String toString() {
+    return 'Note{id: $id, author: $author, createdAt: $createdAt}';
+  }
+
+  factory Note.fromJson(Map<String, dynamic> json) {
+    return Note(
+      id: json['ID'],
+      author: json['Author'] ?? '',
+      body: json['Body'] ?? '',
+      createdAt: DateTime.parse(json['CreatedAt']).toLocal(),
+    );
+  }
+}
@@ -0,0 +1,78 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	attachmentmodel "stockify_backend_golang/src/feature/attachment/model"
+	attachmentrepository "stockify_backend_golang/src/feature/attachment/repository"
+	attachmentservice "stockify_backend_golang/src/feature/attachment/service"
+)
+
+var attachmentRepository = attachmentrepository.AttachmentRepositoryImplementation()
+var attachmentService = attachmentservice.AttachmentServiceImplementation(attachmentRepository, itemService, userRepository)
+
+// ========== Attachment Functions ==========
+
+// AddAttachment stores the file at path and links it to an item or user.
+// attachmentJSON is an Attachment with ItemID or UserID set and optionally
+// Kind, FileName and Note. Returns the stored attachment with its size and
+// detected MIME type.
+//
+//export AddAttachment
+func AddAttachment(attachmentJSON *C.char, path *C.char) *C.char {
+	var attachment attachmentmodel.Attachment
+	if err := json.Unmarshal([]byte(cStringToGo(attachmentJSON)), &attachment); err != nil {
+		return jsonError("Invalid attachment: " + err.Error())
+	}
+	added, err := attachmentService.AddAttachment(attachment, cStringToGo(path))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(added, "attachment")
+}
+
+//export GetAttachmentById
+func GetAttachmentById(id C.ulonglong) *C.char {
+	attachment := attachmentService.GetAttachmentById(uint64(id))
+	if attachment.ID == 0 {
+		return jsonError("Attachment not found")
+	}
+	return jsonResult(attachment, "attachment")
+}
+
+//export GetItemAttachments
+func GetItemAttachments(itemId C.ulonglong) *C.char {
+	return jsonResult(attachmentService.GetAttachmentsByItemId(uint64(itemId)), "attachments")
+}
+
+//export GetUserAttachments
+func GetUserAttachments(userId C.ulonglong) *C.char {
+	return jsonResult(attachmentService.GetAttachmentsByUserId(uint64(userId)), "attachments")
+}
+
+// GetAttachmentPath returns the path of the stored file for opening it in
+// place; the file must not be modified.
+//
+//export GetAttachmentPath
+func GetAttachmentPath(id C.ulonglong) *C.char {
+	path, err := attachmentService.GetAttachmentPath(uint64(id))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(path, "attachment path")
+}
+
+// ExportAttachment copies the attachment's file to outPath
+//
+//export ExportAttachment
+func ExportAttachment(id C.ulonglong, outPath *C.char) *C.char {
+	return jsonStatus(attachmentService.ExportAttachment(uint64(id), cStringToGo(outPath)))
+}
+
+//export DeleteAttachmentById
+func DeleteAttachmentById(id C.ulonglong) *C.char {
+	return jsonStatus(attachmentService.DeleteAttachmentById(uint64(id)))
+}
@@ -0,0 +1,114 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	auditmodel "stockify_backend_golang/src/feature/audit/model"
+	auditrepository "stockify_backend_golang/src/feature/audit/repository"
+	auditservice "stockify_backend_golang/src/feature/audit/service"
+)
+
+var auditRepository = auditrepository.AuditRepositoryImplementation()
+var auditService = auditservice.AuditServiceImplementation(auditRepository, itemService, locationService, lifecycleService)
+
+// ========== Audit Functions ==========
+
+//export GetAllAuditSessions
+func GetAllAuditSessions() *C.char {
+	return jsonResult(auditService.GetAllSessions(), "audit sessions")
+}
+
+//export GetAuditSessionById
+func GetAuditSessionById(id C.ulonglong) *C.char {
+	session := auditService.GetSessionById(uint64(id))
+	if session.ID == 0 {
+		return jsonError("Audit session not found")
+	}
+	return jsonResult(session, "audit session")
+}
+
+// StartAuditSession takes an AuditSession as JSON, with a name and the
+// location and item filter that make up its scope, and returns the started
+// session.
+//
+//export StartAuditSession
+func StartAuditSession(sessionJSON *C.char) *C.char {
+	var session auditmodel.AuditSession
+	if err := json.Unmarshal([]byte(cStringToGo(sessionJSON)), &session); err != nil {
+		return jsonError("Invalid audit session: " + err.Error())
+	}
+	started, err := auditService.StartSession(session)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(started, "audit session")
+}
+
+//export CloseAuditSession
+func CloseAuditSession(id C.ulonglong) *C.char {
+	return jsonStatus(auditService.CloseSession(uint64(id)))
+}
+
+//export DeleteAuditSessionById
+func DeleteAuditSessionById(id C.ulonglong) *C.char {
+	return jsonStatus(auditService.DeleteSessionById(uint64(id)))
+}
+
+// RecordAuditScan records a scanned asset or serial number and returns the
+// scan, whose ItemID tells whether it matched an item. A locationId of 0
+// means the session's location, a userId of 0 no user.
+//
+//export RecordAuditScan
+func RecordAuditScan(sessionId C.ulonglong, value *C.char, locationId, userId C.ulonglong) *C.char {
+	var user *uint64
+	if userId != 0 {
+		id := uint64(userId)
+		user = &id
+	}
+	scan, err := auditService.RecordScan(uint64(sessionId), cStringToGo(value), locationIdOrNil(locationId), user)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(scan, "audit scan")
+}
+
+//export GetAuditScans
+func GetAuditScans(sessionId C.ulonglong) *C.char {
+	return jsonResult(auditService.GetScans(uint64(sessionId)), "audit scans")
+}
+
+//export DeleteAuditScanById
+func DeleteAuditScanById(id C.ulonglong) {
+	auditService.DeleteScanById(uint64(id))
+}
+
+// GetAuditReconciliation returns the found, missing, unexpected, unknown and
+// misplaced items of a session
+//
+//export GetAuditReconciliation
+func GetAuditReconciliation(sessionId C.ulonglong) *C.char {
+	reconciliation, err := auditService.GetReconciliation(uint64(sessionId))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(reconciliation, "audit reconciliation")
+}
+
+// ApplyAuditResult takes AuditActions as JSON and applies them to the items
+// of the session's reconciliation
+//
+//export ApplyAuditResult
+func ApplyAuditResult(sessionId C.ulonglong, actionsJSON *C.char) *C.char {
+	var actions auditmodel.AuditActions
+	if err := json.Unmarshal([]byte(cStringToGo(actionsJSON)), &actions); err != nil {
+		return jsonError("Invalid audit actions: " + err.Error())
+	}
+	result, err := auditService.ApplyResult(uint64(sessionId), actions)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(result, "audit result")
+}
@@ -0,0 +1,22 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	backuprepository "stockify_backend_golang/src/feature/backup/repository"
+	backupservice "stockify_backend_golang/src/feature/backup/service"
+)
+
+var backupService = backupservice.BackupServiceImplementation(backuprepository.BackupRepositoryImplementation())
+
+// ========== Backup Functions ==========
+
+// CreateBackup writes a zip archive of the database and all attachment
+// files to outPath.
+//
+//export CreateBackup
+func CreateBackup(outPath *C.char) *C.char {
+	return jsonStatus(backupService.CreateBackup(cStringToGo(outPath)))
+}
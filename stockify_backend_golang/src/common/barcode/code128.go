@@ -0,0 +1,116 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Bar and space widths, in modules, of the Code 128 symbols 0..106. Each
+// symbol is three bars and three spaces eleven modules wide; the stop symbol
+// adds a final two-module bar.
+var code128Patterns = [107]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+const (
+	code128CodeC  = 99
+	code128CodeB  = 100
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// Code128QuietZone is the light margin, in modules, needed on either side
+const Code128QuietZone = 10
+
+// EncodeCode128 encodes printable ASCII text as Code 128 and returns the
+// modules from the start to the stop symbol, true for a bar. Runs of four
+// or more digits switch to code set C, which packs two digits per symbol and
+// keeps numeric asset tags short.
+func EncodeCode128(text string) ([]bool, error) {
+	if text == "" {
+		return nil, errors.New("nothing to encode")
+	}
+	for _, r := range text {
+		if r < 32 || r > 126 {
+			return nil, fmt.Errorf("code 128 cannot encode %q", r)
+		}
+	}
+
+	var symbols []int
+	codeC := false
+	for i := 0; i < len(text); {
+		digits := digitRun(text[i:])
+		// Set C pays off from four digits on, or for any pair once in set C
+		useC := digits >= 4 || (digits >= 2 && codeC)
+		if useC {
+			if digits%2 == 1 && !codeC {
+				// One digit in set B first so that the rest pairs up
+				if len(symbols) == 0 {
+					symbols = append(symbols, code128StartB)
+				}
+				symbols = append(symbols, int(text[i])-32)
+				i++
+				digits--
+			}
+			switch {
+			case len(symbols) == 0:
+				symbols = append(symbols, code128StartC)
+			case !codeC:
+				symbols = append(symbols, code128CodeC)
+			}
+			codeC = true
+			for ; digits >= 2; digits -= 2 {
+				symbols = append(symbols, int(text[i]-'0')*10+int(text[i+1]-'0'))
+				i += 2
+			}
+			continue
+		}
+		switch {
+		case len(symbols) == 0:
+			symbols = append(symbols, code128StartB)
+		case codeC:
+			symbols = append(symbols, code128CodeB)
+		}
+		codeC = false
+		symbols = append(symbols, int(text[i])-32)
+		i++
+	}
+
+	checksum := symbols[0]
+	for i, symbol := range symbols[1:] {
+		checksum += (i + 1) * symbol
+	}
+	symbols = append(symbols, checksum%103, code128Stop)
+
+	var pattern strings.Builder
+	for _, symbol := range symbols {
+		pattern.WriteString(code128Patterns[symbol])
+	}
+	var modules []bool
+	for i, width := range pattern.String() {
+		for n := 0; n < int(width-'0'); n++ {
+			modules = append(modules, i%2 == 0)
+		}
+	}
+	return modules, nil
+}
+
+func digitRun(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,67 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeCode128(t *testing.T) {
+	// Expected bar and space widths per symbol, written out from the Code 128
+	// table rather than taken from code128Patterns
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{
+			// Start B, A, B, Code C, 12, 34, check 102, stop
+			text: "AB1234",
+			want: []string{"211214", "111323", "131123", "113141", "112232", "131123", "411131", "2331112"},
+		},
+		{
+			// Start C, 12, 34, Code B, A, B, check 66, stop
+			text: "1234AB",
+			want: []string{"211232", "112232", "131123", "114131", "111323", "131123", "121421", "2331112"},
+		},
+		{
+			// Start B, 1, Code C, 23, 45, check 53, stop: an odd run starts in set B
+			text: "12345",
+			want: []string{"211214", "123221", "113141", "312131", "113123", "213131", "2331112"},
+		},
+	}
+	for _, test := range tests {
+		modules, err := EncodeCode128(test.text)
+		if err != nil {
+			t.Fatalf("EncodeCode128(%q): %v", test.text, err)
+		}
+		// Every symbol but the stop has an even number of elements, so bars
+		// and spaces alternate across the whole string
+		var want []bool
+		for i, width := range strings.Join(test.want, "") {
+			for n := 0; n < int(width-'0'); n++ {
+				want = append(want, i%2 == 0)
+			}
+		}
+		if string(render(modules)) != string(render(want)) {
+			t.Errorf("EncodeCode128(%q) =\n%s\nwant\n%s", test.text, render(modules), render(want))
+		}
+	}
+}
+
+func TestEncodeCode128Invalid(t *testing.T) {
+	for _, text := range []string{"", "tab\there", "café"} {
+		if _, err := EncodeCode128(text); err == nil {
+			t.Errorf("EncodeCode128(%q) succeeded, want an error", text)
+		}
+	}
+}
+
+func render(modules []bool) []byte {
+	result := make([]byte, len(modules))
+	for i, dark := range modules {
+		result[i] = '.'
+		if dark {
+			result[i] = '#'
+		}
+	}
+	return result
+}
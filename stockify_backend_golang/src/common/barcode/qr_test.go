@@ -0,0 +1,82 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+// The expected symbol was cross-checked against an independent encoder: byte
+// mode, version 1, level M, mask 2.
+const pc001QR = `
+#######..#.#..#######
+#.....#..###..#.....#
+#.###.#.#.###.#.###.#
+#.###.#.#.##..#.###.#
+#.###.#.#..##.#.###.#
+#.....#.#...#.#.....#
+#######.#.#.#.#######
+........#..##........
+#.#####..##.#.#####..
+#.#.#...#.#.#..#..#..
+#..##.##.###.#..##.#.
+....##.#.##....##.#..
+..##.#####.#.#..#....
+........##.#####.#.#.
+#######..#..#.##.###.
+#.....#.#######...#.#
+#.###.#.#.#.#..#.#.#.
+#.###.#.#...#...#.#..
+#.###.#.#.##.#.#..#..
+#.....#..##....#..#..
+#######.#.##.#.#.#.#.
+`
+
+func TestEncodeQR(t *testing.T) {
+	q, err := EncodeQR([]byte("PC-001"))
+	if err != nil {
+		t.Fatalf("EncodeQR: %v", err)
+	}
+	if q.Size != 21 {
+		t.Fatalf("Size = %d, want 21", q.Size)
+	}
+	var got strings.Builder
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if q.Dark(x, y) {
+				got.WriteByte('#')
+			} else {
+				got.WriteByte('.')
+			}
+		}
+		got.WriteByte('\n')
+	}
+	if want := strings.TrimPrefix(pc001QR, "\n"); got.String() != want {
+		t.Errorf("EncodeQR(%q) =\n%swant\n%s", "PC-001", got.String(), want)
+	}
+}
+
+func TestEncodeQRVersion(t *testing.T) {
+	tests := []struct {
+		length int
+		size   int
+	}{
+		{14, 21},  // the most a version 1 symbol holds at level M
+		{15, 25},  // one byte more needs version 2
+		{213, 57}, // the most version 10 holds
+	}
+	for _, test := range tests {
+		q, err := EncodeQR([]byte(strings.Repeat("A", test.length)))
+		if err != nil {
+			t.Fatalf("EncodeQR(%d bytes): %v", test.length, err)
+		}
+		if q.Size != test.size {
+			t.Errorf("EncodeQR(%d bytes).Size = %d, want %d", test.length, q.Size, test.size)
+		}
+	}
+}
+
+func TestEncodeQRTooLong(t *testing.T) {
+	if _, err := EncodeQR([]byte(strings.Repeat("A", 214))); err == nil {
+		t.Error("EncodeQR(214 bytes) succeeded, want an error")
+	}
+}
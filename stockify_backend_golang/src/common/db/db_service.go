@@ -11,6 +11,10 @@ import (
 
 var DB *gorm.DB
 
+// DataDir is the app data directory holding inventory.db, where features
+// keep their files
+var DataDir string
+
 func init() {
 	appName := "Stockify"
 	dbFilename := "inventory.db"
@@ -23,6 +27,7 @@ func init() {
 	if err := os.MkdirAll(appDataDir, 0755); err != nil {
 		log.Fatal("Failed to create app data directory:", err)
 	}
+	DataDir = appDataDir
 	// Step 3: Full DB path
 	dbPath := filepath.Join(appDataDir, dbFilename)
 	log.Println("Using SQLite DB at:", dbPath)
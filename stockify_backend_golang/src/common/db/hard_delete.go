@@ -0,0 +1,9 @@
+package db
+
+// HardDelete removes the row with the given id for good instead of marking
+// it deleted. Use it for records holding a unique value, such as a code: a
+// soft-deleted row would keep holding the value in the unique index, so it
+// could never be given to a new record.
+func HardDelete(value interface{}, id uint64) error {
+	return DB.Unscoped().Delete(value, id).Error
+}
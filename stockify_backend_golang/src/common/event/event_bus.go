@@ -0,0 +1,48 @@
+package event
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+type Type string
+
+type Event struct {
+	Type       Type
+	OccurredAt time.Time
+	Data       interface{}
+}
+
+type Handler func(e Event)
+
+var (
+	mu       sync.RWMutex
+	handlers []Handler
+)
+
+// Subscribe registers a handler that is called for every published event.
+func Subscribe(handler Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+	handlers = append(handlers, handler)
+}
+
+// Publish calls every subscribed handler synchronously. A panicking handler is
+// logged and does not stop the others.
+func Publish(eventType Type, data interface{}) {
+	e := Event{Type: eventType, OccurredAt: time.Now(), Data: data}
+	mu.RLock()
+	subscribed := append([]Handler(nil), handlers...)
+	mu.RUnlock()
+	for _, handler := range subscribed {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Println("Event handler for", eventType, "panicked:", r)
+				}
+			}()
+			handler(e)
+		}()
+	}
+}
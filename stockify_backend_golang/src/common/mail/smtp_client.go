@@ -0,0 +1,148 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type TLSMode string
+
+const (
+	TLS_NONE     TLSMode = "none"
+	TLS_STARTTLS TLSMode = "starttls"
+	TLS_IMPLICIT TLSMode = "tls"
+)
+
+type SMTPSettings struct {
+	Host               string
+	Port               int
+	Username           string
+	Password           string
+	TLSMode            TLSMode
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+}
+
+type Message struct {
+	From     string
+	To       []string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// Bytes renders the message as an RFC 5322 email. When both bodies are set the
+// message is sent as multipart/alternative.
+func (m Message) Bytes(date time.Time) []byte {
+	var buf bytes.Buffer
+	writeHeader := func(key, value string) {
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+	writeHeader("From", m.From)
+	writeHeader("To", strings.Join(m.To, ", "))
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	writeHeader("Date", date.Format(time.RFC1123Z))
+	writeHeader("MIME-Version", "1.0")
+
+	switch {
+	case m.HTMLBody != "" && m.TextBody != "":
+		boundary := fmt.Sprintf("stockify-%d", date.UnixNano())
+		writeHeader("Content-Type", `multipart/alternative; boundary="`+boundary+`"`)
+		buf.WriteString("\r\n")
+		for _, part := range []struct{ contentType, body string }{
+			{"text/plain", m.TextBody},
+			{"text/html", m.HTMLBody},
+		} {
+			buf.WriteString("--" + boundary + "\r\n")
+			buf.WriteString("Content-Type: " + part.contentType + "; charset=utf-8\r\n\r\n")
+			buf.WriteString(normalizeNewlines(part.body) + "\r\n")
+		}
+		buf.WriteString("--" + boundary + "--\r\n")
+	case m.HTMLBody != "":
+		writeHeader("Content-Type", "text/html; charset=utf-8")
+		buf.WriteString("\r\n" + normalizeNewlines(m.HTMLBody) + "\r\n")
+	default:
+		writeHeader("Content-Type", "text/plain; charset=utf-8")
+		buf.WriteString("\r\n" + normalizeNewlines(m.TextBody) + "\r\n")
+	}
+	return buf.Bytes()
+}
+
+// Send delivers the message through the configured SMTP server.
+func Send(settings SMTPSettings, message Message) error {
+	if settings.Host == "" {
+		return errors.New("smtp host is not configured")
+	}
+	if len(message.To) == 0 {
+		return errors.New("no recipients")
+	}
+	timeout := settings.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	address := net.JoinHostPort(settings.Host, strconv.Itoa(settings.Port))
+	tlsConfig := &tls.Config{ServerName: settings.Host, InsecureSkipVerify: settings.InsecureSkipVerify}
+
+	var conn net.Conn
+	var err error
+	if settings.TLSMode == TLS_IMPLICIT {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", address, tlsConfig)
+	} else {
+		conn, err = net.DialTimeout("tcp", address, timeout)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	client, err := smtp.NewClient(conn, settings.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if settings.TLSMode == TLS_STARTTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if settings.Username != "" {
+		auth := smtp.PlainAuth("", settings.Username, settings.Password, settings.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(message.From); err != nil {
+		return err
+	}
+	for _, to := range message.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message.Bytes(time.Now())); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func normalizeNewlines(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
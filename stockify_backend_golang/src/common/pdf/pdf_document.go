@@ -0,0 +1,256 @@
+package pdf
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Page sizes in points (1/72 inch)
+const (
+	A4Width      = 595.28
+	A4Height     = 841.89
+	LetterWidth  = 612.0
+	LetterHeight = 792.0
+	PointsPerMM  = 72 / 25.4
+)
+
+const (
+	fontRegular   = "F1"
+	fontBold      = "F2"
+	fontMonospace = "F3"
+)
+
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+	Monospace
+)
+
+// Document is a minimal PDF writer supporting text in the standard Helvetica
+// and Courier fonts, lines and filled rectangles. It needs no font embedding,
+// which keeps generated reports small and dependency free.
+type Document struct {
+	Width  float64
+	Height float64
+	pages  []*Page
+}
+
+// Page collects drawing operators. Coordinates are in points measured from
+// the top-left corner of the page.
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+}
+
+func NewDocument(width, height float64) *Document {
+	return &Document{Width: width, Height: height}
+}
+
+func (d *Document) AddPage() *Page {
+	page := &Page{doc: d}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// Page returns the page at index i, allowing content such as page numbers to
+// be added once the whole document is laid out.
+func (d *Document) Page(i int) *Page {
+	return d.pages[i]
+}
+
+// TextWidth returns the width in points of s set in font at size.
+func TextWidth(s string, font Font, size float64) float64 {
+	if font == Monospace {
+		return float64(len([]rune(s))) * 600 * size / 1000
+	}
+	widths := &helveticaWidths
+	if font == Bold {
+		widths = &helveticaBoldWidths
+	}
+	total := 0
+	for _, r := range s {
+		if r >= 32 && r <= 126 {
+			total += widths[r-32]
+		} else {
+			total += defaultGlyphWidth
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// Truncate shortens s with a trailing ellipsis so that it fits maxWidth.
+func Truncate(s string, font Font, size, maxWidth float64) string {
+	if TextWidth(s, font, size) <= maxWidth {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := string(runes) + "..."
+		if TextWidth(candidate, font, size) <= maxWidth {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// Text draws s with its baseline starting at (x, y).
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	name := fontRegular
+	switch font {
+	case Bold:
+		name = fontBold
+	case Monospace:
+		name = fontMonospace
+	}
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		name, num(size), num(x), num(p.doc.Height-y), escapeText(s))
+}
+
+// TextRight draws s so that it ends at x.
+func (p *Page) TextRight(x, y float64, font Font, size float64, s string) {
+	p.Text(x-TextWidth(s, font, size), y, font, size, s)
+}
+
+// TextCenter draws s centered on x.
+func (p *Page) TextCenter(x, y float64, font Font, size float64, s string) {
+	p.Text(x-TextWidth(s, font, size)/2, y, font, size, s)
+}
+
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n",
+		num(width), num(x1), num(p.doc.Height-y1), num(x2), num(p.doc.Height-y2))
+}
+
+// Rect fills a rectangle whose top-left corner is (x, y).
+func (p *Page) Rect(x, y, w, h float64) {
+	fmt.Fprintf(&p.content, "%s %s %s %s re f\n", num(x), num(p.doc.Height-y-h), num(w), num(h))
+}
+
+// StrokeRect outlines a rectangle whose top-left corner is (x, y).
+func (p *Page) StrokeRect(x, y, w, h, lineWidth float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s %s %s re S\n",
+		num(lineWidth), num(x), num(p.doc.Height-y-h), num(w), num(h))
+}
+
+// SetFillColor sets the RGB fill colour (0..1) used by Rect and Text.
+func (p *Page) SetFillColor(r, g, b float64) {
+	fmt.Fprintf(&p.content, "%s %s %s rg\n", num(r), num(g), num(b))
+}
+
+// SetStrokeColor sets the RGB stroke colour (0..1) used by Line and StrokeRect.
+func (p *Page) SetStrokeColor(r, g, b float64) {
+	fmt.Fprintf(&p.content, "%s %s %s RG\n", num(r), num(g), num(b))
+}
+
+func (d *Document) Save(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := d.WriteTo(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	out := &countingWriter{w: bufio.NewWriter(w)}
+	var offsets []int64
+	object := func(body string) {
+		offsets = append(offsets, out.n)
+		fmt.Fprintf(out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-5 are fixed: catalog, page tree and the three fonts. Each page
+	// then takes two objects, the page itself and its content stream.
+	firstPage := 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %s %s] >>",
+		strings.Join(kids, " "), len(d.pages), num(d.Width), num(d.Height)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /%s 3 0 R /%s 4 0 R /%s 5 0 R >> >> /Contents %d 0 R >>",
+			fontRegular, fontBold, fontMonospace, firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := out.n
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	if out.err != nil {
+		return out.n, out.err
+	}
+	return out.n, out.w.Flush()
+}
+
+// escapeText converts s to WinAnsi bytes inside a PDF literal string.
+// Characters outside Latin-1 are replaced with '?'.
+func escapeText(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= 32 && r <= 126:
+			sb.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		default:
+			sb.WriteByte('?')
+		}
+	}
+	return sb.String()
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
+
+func (c *countingWriter) WriteString(s string) (int, error) {
+	return c.Write([]byte(s))
+}
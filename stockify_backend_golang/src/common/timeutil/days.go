@@ -0,0 +1,16 @@
+package timeutil
+
+import "time"
+
+// DaysBetween counts calendar days from from to to, ignoring the time of day.
+// The result is negative when to is before from.
+func DaysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// StartOfDay returns midnight at the start of t's day in t's location.
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
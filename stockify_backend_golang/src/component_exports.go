@@ -0,0 +1,63 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"stockify_backend_golang/src/common/event"
+	componentrepository "stockify_backend_golang/src/feature/component/repository"
+	componentservice "stockify_backend_golang/src/feature/component/service"
+	"stockify_backend_golang/src/feature/item/model"
+)
+
+var componentRepository = componentrepository.ComponentRepositoryImplementation()
+var componentService = componentservice.ComponentServiceImplementation(componentRepository, itemService)
+
+func init() {
+	event.Subscribe(componentService.HandleEvent)
+}
+
+// ========== Component Functions ==========
+
+// AttachComponent builds an item into a parent item, or moves it there from
+// its current parent. The component takes over the parent's assignee.
+//
+//export AttachComponent
+func AttachComponent(itemId C.ulonglong, parentId C.ulonglong, note *C.char) *C.char {
+	return jsonStatus(componentService.AttachComponent(uint64(itemId), uint64(parentId), cStringToGo(note)))
+}
+
+//export DetachComponent
+func DetachComponent(itemId C.ulonglong, note *C.char) *C.char {
+	return jsonStatus(componentService.DetachComponent(uint64(itemId), cStringToGo(note)))
+}
+
+// GetComponentTree returns the item with its components, nested to any depth
+//
+//export GetComponentTree
+func GetComponentTree(itemId C.ulonglong) *C.char {
+	tree, err := componentService.GetComponentTree(uint64(itemId))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(tree, "component tree")
+}
+
+// GetItemsInside returns every item built into the item, at any depth, as a
+// flat list
+//
+//export GetItemsInside
+func GetItemsInside(itemId C.ulonglong) *C.char {
+	id := uint64(itemId)
+	items, err := itemService.GetFilteredItems(model.ItemFilterParams{InsideItemID: &id})
+	if err != nil {
+		return jsonError("Failed to query components")
+	}
+	return jsonResult(items, "items")
+}
+
+//export GetComponentHistory
+func GetComponentHistory(itemId C.ulonglong) *C.char {
+	return jsonResult(componentService.GetComponentHistory(uint64(itemId)), "component history")
+}
@@ -0,0 +1,108 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	consumablemodel "stockify_backend_golang/src/feature/consumable/model"
+	consumablerepository "stockify_backend_golang/src/feature/consumable/repository"
+	consumableservice "stockify_backend_golang/src/feature/consumable/service"
+)
+
+var consumableRepository = consumablerepository.ConsumableRepositoryImplementation()
+var consumableService = consumableservice.ConsumableServiceImplementation(consumableRepository, deviceTypeRepository, userRepository)
+
+// ========== Consumable Functions ==========
+
+//export GetAllConsumables
+func GetAllConsumables() *C.char {
+	return jsonResult(consumableService.GetAllConsumables(), "consumables")
+}
+
+//export GetConsumableById
+func GetConsumableById(id C.ulonglong) *C.char {
+	consumable := consumableService.GetConsumableById(uint64(id))
+	if consumable.ID == 0 {
+		return jsonError("Consumable not found")
+	}
+	return jsonResult(consumable, "consumable")
+}
+
+// GetFilteredConsumables takes ConsumableQueryParams as JSON.
+//
+//export GetFilteredConsumables
+func GetFilteredConsumables(paramsJSON *C.char) *C.char {
+	var params consumablemodel.ConsumableQueryParams
+	if raw := cStringToGo(paramsJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &params); err != nil {
+			return jsonError("Invalid filter params: " + err.Error())
+		}
+	}
+	consumables, err := consumableService.GetFilteredConsumables(params)
+	if err != nil {
+		return jsonError("Failed to get filtered consumables")
+	}
+	return jsonResult(consumables, "consumables")
+}
+
+//export GetLowStockConsumables
+func GetLowStockConsumables() *C.char {
+	consumables, err := consumableService.GetFilteredConsumables(consumablemodel.ConsumableQueryParams{LowStockOnly: true})
+	if err != nil {
+		return jsonError("Failed to get low stock consumables")
+	}
+	return jsonResult(consumables, "consumables")
+}
+
+//export AddConsumable
+func AddConsumable(consumableJSON *C.char) *C.char {
+	var consumable consumablemodel.Consumable
+	if err := json.Unmarshal([]byte(cStringToGo(consumableJSON)), &consumable); err != nil {
+		return jsonError("Invalid consumable: " + err.Error())
+	}
+	consumable.ID = 0
+	return jsonStatus(consumableService.AddConsumable(consumable))
+}
+
+// UpdateConsumable saves everything but QuantityOnHand, which only stock
+// transactions change.
+//
+//export UpdateConsumable
+func UpdateConsumable(consumableJSON *C.char) *C.char {
+	var consumable consumablemodel.Consumable
+	if err := json.Unmarshal([]byte(cStringToGo(consumableJSON)), &consumable); err != nil {
+		return jsonError("Invalid consumable: " + err.Error())
+	}
+	return jsonStatus(consumableService.UpdateConsumable(consumable))
+}
+
+//export DeleteConsumableById
+func DeleteConsumableById(id C.ulonglong) {
+	consumableService.DeleteConsumableById(uint64(id))
+}
+
+// RecordStockTransaction books a StockTransaction given as JSON and returns
+// the consumable with its new quantity.
+//
+//export RecordStockTransaction
+func RecordStockTransaction(transactionJSON *C.char) *C.char {
+	var transaction consumablemodel.StockTransaction
+	if err := json.Unmarshal([]byte(cStringToGo(transactionJSON)), &transaction); err != nil {
+		return jsonError("Invalid stock transaction: " + err.Error())
+	}
+	consumable, err := consumableService.RecordTransaction(transaction)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(consumable, "consumable")
+}
+
+// GetStockTransactions lists the transactions of a consumable, of a user, or
+// both; pass 0 to leave either out.
+//
+//export GetStockTransactions
+func GetStockTransactions(consumableId, userId C.ulonglong) *C.char {
+	return jsonResult(consumableService.GetTransactions(uint64(consumableId), uint64(userId)), "stock transactions")
+}
@@ -0,0 +1,69 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	"stockify_backend_golang/src/common/event"
+	customfieldmodel "stockify_backend_golang/src/feature/customfield/model"
+	customfieldrepository "stockify_backend_golang/src/feature/customfield/repository"
+	customfieldservice "stockify_backend_golang/src/feature/customfield/service"
+)
+
+var customFieldRepository = customfieldrepository.CustomFieldRepositoryImplementation()
+var customFieldService = customfieldservice.CustomFieldServiceImplementation(
+	customFieldRepository, deviceTypeRepository, itemRepository,
+)
+
+func init() {
+	event.Subscribe(customFieldService.HandleEvent)
+}
+
+// ========== Custom Attribute Functions ==========
+
+//export GetDeviceTypeAttributes
+func GetDeviceTypeAttributes(deviceTypeId C.ulonglong) *C.char {
+	return jsonResult(customFieldService.GetDefinitionsByDeviceTypeId(uint64(deviceTypeId)), "attribute definitions")
+}
+
+//export AddDeviceTypeAttribute
+func AddDeviceTypeAttribute(definitionJSON *C.char) *C.char {
+	var definition customfieldmodel.AttributeDefinition
+	if err := json.Unmarshal([]byte(cStringToGo(definitionJSON)), &definition); err != nil {
+		return jsonError("Invalid attribute definition: " + err.Error())
+	}
+	definition.ID = 0
+	return jsonStatus(customFieldService.AddDefinition(definition))
+}
+
+//export UpdateDeviceTypeAttribute
+func UpdateDeviceTypeAttribute(definitionJSON *C.char) *C.char {
+	var definition customfieldmodel.AttributeDefinition
+	if err := json.Unmarshal([]byte(cStringToGo(definitionJSON)), &definition); err != nil {
+		return jsonError("Invalid attribute definition: " + err.Error())
+	}
+	return jsonStatus(customFieldService.UpdateDefinition(definition))
+}
+
+//export DeleteDeviceTypeAttributeById
+func DeleteDeviceTypeAttributeById(id C.ulonglong) *C.char {
+	return jsonStatus(customFieldService.DeleteDefinitionById(uint64(id)))
+}
+
+//export GetItemAttributes
+func GetItemAttributes(itemId C.ulonglong) *C.char {
+	return jsonResult(customFieldService.GetItemAttributes(uint64(itemId)), "item attributes")
+}
+
+//export SetItemAttributes
+func SetItemAttributes(itemId C.ulonglong, valuesJSON *C.char) *C.char {
+	values := map[string]string{}
+	if raw := cStringToGo(valuesJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &values); err != nil {
+			return jsonError("Invalid attribute values: " + err.Error())
+		}
+	}
+	return jsonStatus(customFieldService.SetItemAttributes(uint64(itemId), values))
+}
@@ -0,0 +1,31 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	dashboardmodel "stockify_backend_golang/src/feature/dashboard/model"
+	dashboardrepository "stockify_backend_golang/src/feature/dashboard/repository"
+	dashboardservice "stockify_backend_golang/src/feature/dashboard/service"
+	"time"
+)
+
+var dashboardRepository = dashboardrepository.DashboardRepositoryImplementation()
+var dashboardService = dashboardservice.DashboardServiceImplementation(dashboardRepository)
+
+// ========== Dashboard Functions ==========
+
+//export GetDashboardStats
+func GetDashboardStats(expiringWithinDays, receivedMonths, topUsers C.int) *C.char {
+	params := dashboardmodel.DashboardParams{
+		ExpiringWithinDays: int(expiringWithinDays),
+		ReceivedMonths:     int(receivedMonths),
+		TopUsers:           int(topUsers),
+	}
+	stats, err := dashboardService.GetStats(params, time.Now())
+	if err != nil {
+		return jsonError("Failed to compute dashboard stats")
+	}
+	return jsonResult(stats, "dashboard stats")
+}
@@ -0,0 +1,72 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	depreciationmodel "stockify_backend_golang/src/feature/depreciation/model"
+	depreciationrepository "stockify_backend_golang/src/feature/depreciation/repository"
+	depreciationservice "stockify_backend_golang/src/feature/depreciation/service"
+	"time"
+)
+
+var depreciationRepository = depreciationrepository.DepreciationRepositoryImplementation()
+var depreciationService = depreciationservice.DepreciationServiceImplementation(depreciationRepository)
+
+// ========== Depreciation Functions ==========
+
+//export GetDepreciationPolicies
+func GetDepreciationPolicies() *C.char {
+	return jsonResult(depreciationService.GetAllPolicies(), "depreciation policies")
+}
+
+//export AddDepreciationPolicy
+func AddDepreciationPolicy(policyJSON *C.char) *C.char {
+	var policy depreciationmodel.DepreciationPolicy
+	if err := json.Unmarshal([]byte(cStringToGo(policyJSON)), &policy); err != nil {
+		return jsonError("Invalid depreciation policy: " + err.Error())
+	}
+	policy.ID = 0
+	return jsonStatus(depreciationService.AddPolicy(policy))
+}
+
+//export UpdateDepreciationPolicy
+func UpdateDepreciationPolicy(policyJSON *C.char) *C.char {
+	var policy depreciationmodel.DepreciationPolicy
+	if err := json.Unmarshal([]byte(cStringToGo(policyJSON)), &policy); err != nil {
+		return jsonError("Invalid depreciation policy: " + err.Error())
+	}
+	return jsonStatus(depreciationService.UpdatePolicy(policy))
+}
+
+//export DeleteDepreciationPolicyById
+func DeleteDepreciationPolicyById(id C.ulonglong) *C.char {
+	return jsonStatus(depreciationService.DeletePolicyById(uint64(id)))
+}
+
+//export GetItemDepreciationSchedule
+func GetItemDepreciationSchedule(itemId C.ulonglong) *C.char {
+	schedule, err := depreciationService.GetItemSchedule(uint64(itemId))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(schedule, "depreciation schedule")
+}
+
+// GetBookValueReport values the inventory at asOf, a Unix timestamp, or now
+// when asOf is 0.
+//
+//export GetBookValueReport
+func GetBookValueReport(asOf C.longlong) *C.char {
+	at := time.Now()
+	if asOf > 0 {
+		at = time.Unix(int64(asOf), 0)
+	}
+	report, err := depreciationService.GetBookValueReport(at)
+	if err != nil {
+		return jsonError("Failed to calculate book values")
+	}
+	return jsonResult(report, "book value report")
+}
@@ -0,0 +1,68 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	devicetypemodel "stockify_backend_golang/src/feature/devicetype/model"
+	devicetypeservice "stockify_backend_golang/src/feature/devicetype/service"
+)
+
+var deviceTypeService = devicetypeservice.DeviceTypeServiceImplementation(deviceTypeRepository)
+
+// ========== Device Type Functions ==========
+
+//export GetAllDeviceTypes
+func GetAllDeviceTypes() *C.char {
+	return jsonResult(deviceTypeService.GetAllDeviceTypes(), "device types")
+}
+
+//export GetDeviceTypeById
+func GetDeviceTypeById(id C.ulonglong) *C.char {
+	deviceType := deviceTypeService.GetDeviceTypeById(uint64(id))
+	if deviceType.ID == 0 {
+		return jsonError("Device type not found")
+	}
+	return jsonResult(deviceType, "device type")
+}
+
+//export AddDeviceType
+func AddDeviceType(code, displayName, iconKey, metadataJSON *C.char, sortOrder C.int) *C.char {
+	deviceType, err := deviceTypeFromC(0, code, displayName, iconKey, metadataJSON, sortOrder)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonStatus(deviceTypeService.AddDeviceType(deviceType))
+}
+
+//export UpdateDeviceType
+func UpdateDeviceType(id C.ulonglong, code, displayName, iconKey, metadataJSON *C.char, sortOrder C.int) *C.char {
+	deviceType, err := deviceTypeFromC(uint64(id), code, displayName, iconKey, metadataJSON, sortOrder)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonStatus(deviceTypeService.UpdateDeviceType(deviceType))
+}
+
+//export DeleteDeviceTypeById
+func DeleteDeviceTypeById(id C.ulonglong) *C.char {
+	return jsonStatus(deviceTypeService.DeleteDeviceTypeById(uint64(id)))
+}
+
+func deviceTypeFromC(id uint64, code, displayName, iconKey, metadataJSON *C.char, sortOrder C.int) (devicetypemodel.DeviceTypeDefinition, error) {
+	deviceType := devicetypemodel.DeviceTypeDefinition{
+		ID:          id,
+		Code:        cStringToGo(code),
+		DisplayName: cStringToGo(displayName),
+		IconKey:     cStringToGo(iconKey),
+		SortOrder:   int(sortOrder),
+	}
+	if raw := cStringToGo(metadataJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &deviceType.Metadata); err != nil {
+			return deviceType, err
+		}
+	}
+	return deviceType, nil
+}
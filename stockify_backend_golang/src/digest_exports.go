@@ -0,0 +1,59 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	digestmodel "stockify_backend_golang/src/feature/digest/model"
+	digestrepository "stockify_backend_golang/src/feature/digest/repository"
+	digestservice "stockify_backend_golang/src/feature/digest/service"
+	"time"
+)
+
+var digestRepository = digestrepository.DigestRepositoryImplementation()
+var digestService = digestservice.DigestServiceImplementation(digestRepository, itemRepository)
+
+// ========== Digest Functions ==========
+
+//export GetDigestConfig
+func GetDigestConfig() *C.char {
+	config := digestService.GetConfig()
+	// Never hand the SMTP password back to the client
+	config.SMTPPassword = ""
+	return jsonResult(config, "digest config")
+}
+
+//export SaveDigestConfig
+func SaveDigestConfig(configJSON *C.char) *C.char {
+	var config digestmodel.DigestConfig
+	if err := json.Unmarshal([]byte(cStringToGo(configJSON)), &config); err != nil {
+		return jsonError("Invalid digest config: " + err.Error())
+	}
+	return jsonStatus(digestService.SaveConfig(config))
+}
+
+//export SendDigestNow
+func SendDigestNow() *C.char {
+	return jsonStatus(digestService.SendDigest(time.Now()))
+}
+
+//export RenderDigestToFile
+func RenderDigestToFile(outPath *C.char) *C.char {
+	return jsonStatus(digestService.WriteDigestToFile(time.Now(), cStringToGo(outPath)))
+}
+
+//export StartDigestScheduler
+func StartDigestScheduler(intervalSeconds C.longlong) {
+	interval := time.Duration(intervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	digestService.StartScheduler(interval)
+}
+
+//export StopDigestScheduler
+func StopDigestScheduler() {
+	digestService.StopScheduler()
+}
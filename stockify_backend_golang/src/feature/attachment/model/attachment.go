@@ -0,0 +1,44 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// AttachmentKind says what a file attached to an item or user is
+type AttachmentKind string
+
+const (
+	INVOICE  AttachmentKind = "invoice"
+	PHOTO    AttachmentKind = "photo"
+	WARRANTY AttachmentKind = "warranty"
+	OTHER    AttachmentKind = "other"
+)
+
+var AttachmentKinds = []AttachmentKind{INVOICE, PHOTO, WARRANTY, OTHER}
+
+// MaxAttachmentSize is the largest file accepted, 25 MiB
+const MaxAttachmentSize = 25 << 20
+
+// Attachment is the metadata of a file linked to an item or a user. The file
+// itself is stored once per content under its SHA-256, so attaching the same
+// invoice to ten items takes the space of one. Attachments stay when their
+// item or user is deleted, since that only hides the record and the files,
+// such as a leaver's signed hand-over, are still needed.
+type Attachment struct {
+	gorm.Model
+	ID       uint64         `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID   *uint64        `gorm:"index" json:"ItemID,omitempty"`
+	UserID   *uint64        `gorm:"index" json:"UserID,omitempty"`
+	Kind     AttachmentKind `json:"Kind"`
+	FileName string         `json:"FileName"`
+	MimeType string         `json:"MimeType"`
+	Size     int64          `json:"Size"`
+	SHA256   string         `gorm:"index" json:"SHA256"`
+	Note     string         `json:"Note,omitempty"`
+}
+
+func (a *Attachment) String() string {
+	return fmt.Sprintf("Attachment{ID: %d, FileName: %s, MimeType: %s, Size: %d}", a.ID, a.FileName, a.MimeType, a.Size)
+}
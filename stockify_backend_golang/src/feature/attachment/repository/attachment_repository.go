@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"io"
+	"stockify_backend_golang/src/feature/attachment/model"
+)
+
+type AttachmentRepository interface {
+	GetAttachmentById(id uint64) model.Attachment
+	GetAttachmentsByItemId(itemId uint64) []model.Attachment
+	GetAttachmentsByUserId(userId uint64) []model.Attachment
+	AddAttachment(attachment *model.Attachment) error
+	DeleteAttachmentById(id uint64) error
+
+	StoreBlob(r io.Reader, maxSize int64) (hash string, size int64, head []byte, err error)
+	BlobPath(hash string) string
+}
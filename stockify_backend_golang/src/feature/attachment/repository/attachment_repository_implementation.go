@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/attachment/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Attachment{})
+	if err != nil {
+		log.Fatal("Failed to migrate Attachment table: " + err.Error())
+	}
+}
+
+type attachmentRepository struct{}
+
+func AttachmentRepositoryImplementation() AttachmentRepository {
+	return &attachmentRepository{}
+}
+
+func (r *attachmentRepository) GetAttachmentById(id uint64) model.Attachment {
+	var attachment model.Attachment
+	db.DB.First(&attachment, id)
+	return attachment
+}
+
+func (r *attachmentRepository) GetAttachmentsByItemId(itemId uint64) []model.Attachment {
+	var attachments []model.Attachment
+	db.DB.Where("item_id = ?", itemId).Order("created_at DESC").Find(&attachments)
+	return attachments
+}
+
+func (r *attachmentRepository) GetAttachmentsByUserId(userId uint64) []model.Attachment {
+	var attachments []model.Attachment
+	db.DB.Where("user_id = ?", userId).Order("created_at DESC").Find(&attachments)
+	return attachments
+}
+
+func (r *attachmentRepository) AddAttachment(attachment *model.Attachment) error {
+	return db.DB.Create(attachment).Error
+}
+
+// DeleteAttachmentById removes the metadata for good and the stored file once
+// no other attachment shares its content.
+func (r *attachmentRepository) DeleteAttachmentById(id uint64) error {
+	attachment := r.GetAttachmentById(id)
+	if attachment.ID == 0 {
+		return nil
+	}
+	if err := db.DB.Unscoped().Delete(&model.Attachment{}, id).Error; err != nil {
+		return err
+	}
+	var shared int64
+	db.DB.Model(&model.Attachment{}).Where("sha256 = ?", attachment.SHA256).Count(&shared)
+	if shared == 0 {
+		return removeBlob(attachment.SHA256)
+	}
+	return nil
+}
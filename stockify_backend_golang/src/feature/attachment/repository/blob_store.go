@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"stockify_backend_golang/src/common/db"
+	"strings"
+)
+
+// AttachmentsDir is where attachment files are kept, next to inventory.db.
+// Files are named by the SHA-256 of their content and spread over
+// subdirectories by the first two hex digits.
+func AttachmentsDir() string {
+	return filepath.Join(db.DataDir, "attachments")
+}
+
+// uploadPrefix names the temporary files an upload is written to before it
+// is moved into place
+const uploadPrefix = "upload-"
+
+// IsPartialUpload tells whether a file in the store is an upload still being
+// written rather than a stored attachment
+func IsPartialUpload(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), uploadPrefix)
+}
+
+// headSize is how much of a file is kept for content type detection
+const headSize = 512
+
+// StoreBlob copies r into the store and returns its hash, size and first
+// bytes. Content already stored is not written twice. A file larger than
+// maxSize is rejected without being kept.
+func (r *attachmentRepository) StoreBlob(src io.Reader, maxSize int64) (string, int64, []byte, error) {
+	dir := AttachmentsDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", 0, nil, err
+	}
+	tmp, err := os.CreateTemp(dir, uploadPrefix+"*")
+	if err != nil {
+		return "", 0, nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	head := &headWriter{}
+	size, err := io.Copy(io.MultiWriter(tmp, hash, head), io.LimitReader(src, maxSize+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if size > maxSize {
+		return "", 0, nil, fmt.Errorf("file is larger than %d MiB", maxSize>>20)
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	path := r.BlobPath(sum)
+	if _, err := os.Stat(path); err == nil {
+		return sum, size, head.bytes, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", 0, nil, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, nil, err
+	}
+	return sum, size, head.bytes, nil
+}
+
+func (r *attachmentRepository) BlobPath(hash string) string {
+	return filepath.Join(AttachmentsDir(), hash[:2], hash)
+}
+
+func removeBlob(hash string) error {
+	err := os.Remove(filepath.Join(AttachmentsDir(), hash[:2], hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// headWriter keeps the first headSize bytes written to it
+type headWriter struct {
+	bytes []byte
+}
+
+func (w *headWriter) Write(p []byte) (int, error) {
+	if room := headSize - len(w.bytes); room > 0 {
+		w.bytes = append(w.bytes, p[:min(room, len(p))]...)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,13 @@
+package service
+
+import "stockify_backend_golang/src/feature/attachment/model"
+
+type AttachmentService interface {
+	GetAttachmentById(id uint64) model.Attachment
+	GetAttachmentsByItemId(itemId uint64) []model.Attachment
+	GetAttachmentsByUserId(userId uint64) []model.Attachment
+	AddAttachment(attachment model.Attachment, sourcePath string) (model.Attachment, error)
+	GetAttachmentPath(id uint64) (string, error)
+	ExportAttachment(id uint64, outPath string) error
+	DeleteAttachmentById(id uint64) error
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"stockify_backend_golang/src/feature/attachment/model"
+	"stockify_backend_golang/src/feature/attachment/repository"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	userrepository "stockify_backend_golang/src/feature/user/repository"
+	"strings"
+)
+
+type attachmentService struct {
+	repo        repository.AttachmentRepository
+	itemService itemservice.ItemService
+	userRepo    userrepository.UserRepository
+}
+
+func AttachmentServiceImplementation(
+	repo repository.AttachmentRepository,
+	itemService itemservice.ItemService,
+	userRepo userrepository.UserRepository,
+) AttachmentService {
+	return &attachmentService{repo: repo, itemService: itemService, userRepo: userRepo}
+}
+
+func (s *attachmentService) GetAttachmentById(id uint64) model.Attachment {
+	return s.repo.GetAttachmentById(id)
+}
+
+func (s *attachmentService) GetAttachmentsByItemId(itemId uint64) []model.Attachment {
+	return s.repo.GetAttachmentsByItemId(itemId)
+}
+
+func (s *attachmentService) GetAttachmentsByUserId(userId uint64) []model.Attachment {
+	return s.repo.GetAttachmentsByUserId(userId)
+}
+
+// AddAttachment copies the file at sourcePath into the attachment store and
+// links it to the item or user named in the attachment. The file name
+// defaults to that of the source; size, hash and MIME type come from the
+// content.
+func (s *attachmentService) AddAttachment(attachment model.Attachment, sourcePath string) (model.Attachment, error) {
+	switch {
+	case attachment.ItemID == nil && attachment.UserID == nil:
+		return attachment, errors.New("an attachment belongs to an item or a user")
+	case attachment.ItemID != nil && attachment.UserID != nil:
+		return attachment, errors.New("an attachment belongs to either an item or a user, not both")
+	case attachment.ItemID != nil && s.itemService.GetItemById(*attachment.ItemID).ID == 0:
+		return attachment, errors.New("item not found")
+	case attachment.UserID != nil && s.userRepo.GetUserById(*attachment.UserID).ID == 0:
+		return attachment, errors.New("user not found")
+	}
+	if attachment.Kind == "" {
+		attachment.Kind = model.OTHER
+	}
+	if !slices.Contains(model.AttachmentKinds, attachment.Kind) {
+		return attachment, fmt.Errorf("unknown attachment kind %q", attachment.Kind)
+	}
+
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return attachment, err
+	}
+	defer file.Close()
+	hash, size, head, err := s.repo.StoreBlob(file, model.MaxAttachmentSize)
+	if err != nil {
+		return attachment, err
+	}
+
+	attachment.ID = 0
+	attachment.FileName = strings.TrimSpace(attachment.FileName)
+	if attachment.FileName == "" {
+		attachment.FileName = filepath.Base(sourcePath)
+	}
+	attachment.SHA256 = hash
+	attachment.Size = size
+	attachment.MimeType = detectMimeType(attachment.FileName, head)
+	attachment.Note = strings.TrimSpace(attachment.Note)
+	err = s.repo.AddAttachment(&attachment)
+	return attachment, err
+}
+
+// GetAttachmentPath returns where the attachment's file is stored, for
+// opening it in place. The file must not be modified.
+func (s *attachmentService) GetAttachmentPath(id uint64) (string, error) {
+	attachment := s.repo.GetAttachmentById(id)
+	if attachment.ID == 0 {
+		return "", errors.New("attachment not found")
+	}
+	path := s.repo.BlobPath(attachment.SHA256)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("file of attachment %s is missing", attachment.FileName)
+	}
+	return path, nil
+}
+
+// ExportAttachment copies the attachment's file to outPath
+func (s *attachmentService) ExportAttachment(id uint64, outPath string) error {
+	path, err := s.GetAttachmentPath(id)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+func (s *attachmentService) DeleteAttachmentById(id uint64) error {
+	return s.repo.DeleteAttachmentById(id)
+}
+
+// detectMimeType sniffs the content, falling back to the file extension for
+// formats sniffing cannot tell apart, such as Office documents, which are
+// zip files inside.
+func detectMimeType(fileName string, head []byte) string {
+	detected := http.DetectContentType(head)
+	switch detected {
+	case "application/octet-stream", "application/zip", "text/plain; charset=utf-8", "text/xml; charset=utf-8":
+		if byExtension := mime.TypeByExtension(strings.ToLower(filepath.Ext(fileName))); byExtension != "" {
+			return byExtension
+		}
+	}
+	return detected
+}
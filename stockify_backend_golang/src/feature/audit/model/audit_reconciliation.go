@@ -0,0 +1,51 @@
+package model
+
+import itemmodel "stockify_backend_golang/src/feature/item/model"
+
+// MisplacedItem is an item scanned somewhere other than its recorded
+// location, or the subtree below it
+type MisplacedItem struct {
+	Item              itemmodel.Item `json:"Item"`
+	ScannedLocationID uint64         `json:"ScannedLocationID"`
+}
+
+// AuditReconciliation compares the scans of a session with its scope.
+//
+//   - Found items were in scope and scanned, Missing ones were not scanned.
+//   - Unexpected items were scanned but not in scope; Unknown lists scanned
+//     values that match no item at all.
+//   - Misplaced items, in scope or not, were scanned away from their
+//     recorded location.
+type AuditReconciliation struct {
+	Session    AuditSession     `json:"Session"`
+	Found      []itemmodel.Item `json:"Found"`
+	Missing    []itemmodel.Item `json:"Missing"`
+	Unexpected []itemmodel.Item `json:"Unexpected"`
+	Unknown    []string         `json:"Unknown"`
+	Misplaced  []MisplacedItem  `json:"Misplaced"`
+}
+
+// AuditActions are the changes ApplyAuditResult makes in bulk. Empty fields
+// are skipped.
+type AuditActions struct {
+	// Status for the missing items, typically Lost
+	MissingStatus itemmodel.AssetStatus `json:"MissingStatus"`
+	// Status for found items that were recorded as Lost
+	RecoveredStatus itemmodel.AssetStatus `json:"RecoveredStatus"`
+	// Record misplaced items at the location they were scanned
+	RelocateMisplaced bool `json:"RelocateMisplaced"`
+	// Transition fields required by the lifecycle for the status changes
+	Fields map[string]string `json:"Fields,omitempty"`
+}
+
+// AuditApplyResult counts the changes made. An item the lifecycle refuses
+// to move is reported and left alone.
+type AuditApplyResult struct {
+	Updated int               `json:"Updated"`
+	Errors  []AuditApplyError `json:"Errors"`
+}
+
+type AuditApplyError struct {
+	AssetNo string `json:"AssetNo"`
+	Message string `json:"Message"`
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AuditSession is one physical stocktake. Its scope is the location it
+// covers, including everything below it, narrowed by an optional item
+// filter. The items in scope are recorded when the session starts, so that
+// later changes to the inventory do not move the goalposts.
+type AuditSession struct {
+	gorm.Model
+	ID         uint64                      `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name       string                      `json:"Name"`
+	LocationID *uint64                     `gorm:"index" json:"LocationID,omitempty"`
+	Filter     *itemmodel.ItemFilterParams `gorm:"serializer:json" json:"Filter,omitempty"`
+	StartedAt  time.Time                   `json:"StartedAt"`
+	ClosedAt   *time.Time                  `json:"ClosedAt,omitempty"`
+}
+
+func (s *AuditSession) String() string {
+	return fmt.Sprintf("AuditSession{ID: %d, Name: %s, StartedAt: %s}", s.ID, s.Name, s.StartedAt.Format(time.DateTime))
+}
+
+// AuditExpectedItem is an item that was in scope when the session started
+type AuditExpectedItem struct {
+	SessionID uint64 `gorm:"primaryKey" json:"SessionID"`
+	ItemID    uint64 `gorm:"primaryKey" json:"ItemID"`
+}
+
+// AuditScan is a scanned asset or serial number. ItemID is the item it was
+// matched to, nil when no item carries the value. LocationID is where it was
+// scanned, the session's location when not given.
+type AuditScan struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement" json:"ID"`
+	SessionID  uint64    `gorm:"index" json:"SessionID"`
+	Value      string    `json:"Value"`
+	ItemID     *uint64   `gorm:"index" json:"ItemID,omitempty"`
+	LocationID *uint64   `json:"LocationID,omitempty"`
+	UserID     *uint64   `json:"UserID,omitempty"`
+	ScannedAt  time.Time `json:"ScannedAt"`
+}
+
+func (s *AuditScan) String() string {
+	return fmt.Sprintf("AuditScan{ID: %d, SessionID: %d, Value: %s}", s.ID, s.SessionID, s.Value)
+}
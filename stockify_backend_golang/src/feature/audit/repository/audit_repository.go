@@ -0,0 +1,17 @@
+package repository
+
+import "stockify_backend_golang/src/feature/audit/model"
+
+type AuditRepository interface {
+	GetAllSessions() []model.AuditSession
+	GetSessionById(id uint64) model.AuditSession
+	AddSession(session *model.AuditSession, itemIds []uint64) error
+	UpdateSession(session model.AuditSession) error
+	DeleteSessionById(id uint64) error
+	GetExpectedItemIds(sessionId uint64) []uint64
+	AddScan(scan *model.AuditScan) error
+	GetScans(sessionId uint64) []model.AuditScan
+	DeleteScanById(id uint64)
+	FindItemIdByCode(code string) uint64
+	GetLocationSubtreeIds(locationId uint64) []uint64
+}
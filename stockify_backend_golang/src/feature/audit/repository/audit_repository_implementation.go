@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/audit/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	locationrepository "stockify_backend_golang/src/feature/location/repository"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.AuditSession{}, &model.AuditExpectedItem{}, &model.AuditScan{})
+	if err != nil {
+		log.Fatal("Failed to migrate AuditSession table: " + err.Error())
+	}
+}
+
+type auditRepository struct{}
+
+func AuditRepositoryImplementation() AuditRepository {
+	return &auditRepository{}
+}
+
+func (r *auditRepository) GetAllSessions() []model.AuditSession {
+	var sessions []model.AuditSession
+	db.DB.Order("started_at DESC").Find(&sessions)
+	return sessions
+}
+
+func (r *auditRepository) GetSessionById(id uint64) model.AuditSession {
+	var session model.AuditSession
+	db.DB.First(&session, id)
+	return session
+}
+
+// AddSession stores the session together with the items in its scope
+func (r *auditRepository) AddSession(session *model.AuditSession, itemIds []uint64) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+		expected := make([]model.AuditExpectedItem, len(itemIds))
+		for i, id := range itemIds {
+			expected[i] = model.AuditExpectedItem{SessionID: session.ID, ItemID: id}
+		}
+		if len(expected) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(expected, 500).Error
+	})
+}
+
+func (r *auditRepository) UpdateSession(session model.AuditSession) error {
+	return db.DB.Save(&session).Error
+}
+
+func (r *auditRepository) DeleteSessionById(id uint64) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id = ?", id).Delete(&model.AuditScan{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("session_id = ?", id).Delete(&model.AuditExpectedItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.AuditSession{}, id).Error
+	})
+}
+
+func (r *auditRepository) GetExpectedItemIds(sessionId uint64) []uint64 {
+	var ids []uint64
+	db.DB.Model(&model.AuditExpectedItem{}).Where("session_id = ?", sessionId).Pluck("item_id", &ids)
+	return ids
+}
+
+func (r *auditRepository) AddScan(scan *model.AuditScan) error {
+	return db.DB.Create(scan).Error
+}
+
+func (r *auditRepository) GetScans(sessionId uint64) []model.AuditScan {
+	var scans []model.AuditScan
+	db.DB.Where("session_id = ?", sessionId).Order("scanned_at, id").Find(&scans)
+	return scans
+}
+
+func (r *auditRepository) DeleteScanById(id uint64) {
+	db.DB.Delete(&model.AuditScan{}, id)
+}
+
+// FindItemIdByCode returns the item with the code as asset number or, failing
+// that, as serial number, ignoring case. Zero when there is none.
+func (r *auditRepository) FindItemIdByCode(code string) uint64 {
+	var ids []uint64
+	db.DB.Model(&itemmodel.Item{}).Where("asset_no = ? COLLATE NOCASE", code).Limit(1).Pluck("id", &ids)
+	if len(ids) == 0 {
+		db.DB.Model(&itemmodel.Item{}).Where("serial_no = ? COLLATE NOCASE AND serial_no <> ''", code).Limit(1).Pluck("id", &ids)
+	}
+	if len(ids) == 0 {
+		return 0
+	}
+	return ids[0]
+}
+
+// GetLocationSubtreeIds returns the location and every location below it
+func (r *auditRepository) GetLocationSubtreeIds(locationId uint64) []uint64 {
+	var ids []uint64
+	db.DB.Raw(locationrepository.SubtreeQuery, locationId).Scan(&ids)
+	return ids
+}
@@ -0,0 +1,16 @@
+package service
+
+import "stockify_backend_golang/src/feature/audit/model"
+
+type AuditService interface {
+	GetAllSessions() []model.AuditSession
+	GetSessionById(id uint64) model.AuditSession
+	StartSession(session model.AuditSession) (model.AuditSession, error)
+	CloseSession(id uint64) error
+	DeleteSessionById(id uint64) error
+	RecordScan(sessionId uint64, value string, locationId *uint64, userId *uint64) (model.AuditScan, error)
+	GetScans(sessionId uint64) []model.AuditScan
+	DeleteScanById(id uint64)
+	GetReconciliation(sessionId uint64) (model.AuditReconciliation, error)
+	ApplyResult(sessionId uint64, actions model.AuditActions) (model.AuditApplyResult, error)
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"stockify_backend_golang/src/feature/audit/model"
+	"stockify_backend_golang/src/feature/audit/repository"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	lifecycleservice "stockify_backend_golang/src/feature/lifecycle/service"
+	locationservice "stockify_backend_golang/src/feature/location/service"
+	"strings"
+	"time"
+)
+
+type auditService struct {
+	repo             repository.AuditRepository
+	itemService      itemservice.ItemService
+	locationService  locationservice.LocationService
+	lifecycleService lifecycleservice.LifecycleService
+}
+
+func AuditServiceImplementation(
+	repo repository.AuditRepository,
+	itemService itemservice.ItemService,
+	locationService locationservice.LocationService,
+	lifecycleService lifecycleservice.LifecycleService,
+) AuditService {
+	return &auditService{
+		repo:             repo,
+		itemService:      itemService,
+		locationService:  locationService,
+		lifecycleService: lifecycleService,
+	}
+}
+
+func (s *auditService) GetAllSessions() []model.AuditSession {
+	return s.repo.GetAllSessions()
+}
+
+func (s *auditService) GetSessionById(id uint64) model.AuditSession {
+	return s.repo.GetSessionById(id)
+}
+
+// StartSession records the items in scope, leaving out disposed ones, and
+// opens the session for scanning.
+func (s *auditService) StartSession(session model.AuditSession) (model.AuditSession, error) {
+	session.Name = strings.TrimSpace(session.Name)
+	if session.Name == "" {
+		return session, errors.New("audit session name is required")
+	}
+	if session.LocationID != nil && s.locationService.GetLocationById(*session.LocationID).ID == 0 {
+		return session, errors.New("location not found")
+	}
+
+	var filter itemmodel.ItemFilterParams
+	if session.Filter != nil {
+		filter = *session.Filter
+	}
+	// The session's location narrows the filter; without one the filter's
+	// own location, if any, stays in force
+	if session.LocationID != nil {
+		filter.LocationID = session.LocationID
+	}
+	items, err := s.itemService.GetFilteredItems(filter)
+	if err != nil {
+		return session, err
+	}
+	var itemIds []uint64
+	for _, item := range items {
+		if item.AssetStatus != itemmodel.DISPOSED {
+			itemIds = append(itemIds, item.ID)
+		}
+	}
+
+	session.ID = 0
+	session.StartedAt = time.Now()
+	session.ClosedAt = nil
+	err = s.repo.AddSession(&session, itemIds)
+	return session, err
+}
+
+func (s *auditService) CloseSession(id uint64) error {
+	session := s.repo.GetSessionById(id)
+	if session.ID == 0 {
+		return errors.New("audit session not found")
+	}
+	if session.ClosedAt != nil {
+		return errors.New("audit session is already closed")
+	}
+	now := time.Now()
+	session.ClosedAt = &now
+	return s.repo.UpdateSession(session)
+}
+
+func (s *auditService) DeleteSessionById(id uint64) error {
+	return s.repo.DeleteSessionById(id)
+}
+
+// RecordScan matches a scanned value to an item by asset number, then serial
+// number. A value that matches nothing is still recorded and reported as
+// unknown. Scanning a label whose QR code holds a URL works too: the last
+// path segment is tried as the asset number.
+func (s *auditService) RecordScan(sessionId uint64, value string, locationId *uint64, userId *uint64) (model.AuditScan, error) {
+	session := s.repo.GetSessionById(sessionId)
+	if session.ID == 0 {
+		return model.AuditScan{}, errors.New("audit session not found")
+	}
+	if session.ClosedAt != nil {
+		return model.AuditScan{}, errors.New("audit session is closed")
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return model.AuditScan{}, errors.New("scanned value is empty")
+	}
+	if locationId != nil && s.locationService.GetLocationById(*locationId).ID == 0 {
+		return model.AuditScan{}, errors.New("location not found")
+	}
+	if locationId == nil {
+		locationId = session.LocationID
+	}
+
+	scan := model.AuditScan{
+		SessionID:  sessionId,
+		Value:      value,
+		LocationID: locationId,
+		UserID:     userId,
+		ScannedAt:  time.Now(),
+	}
+	itemId := s.repo.FindItemIdByCode(value)
+	if itemId == 0 && strings.Contains(value, "/") {
+		itemId = s.repo.FindItemIdByCode(value[strings.LastIndex(value, "/")+1:])
+	}
+	if itemId != 0 {
+		scan.ItemID = &itemId
+	}
+	err := s.repo.AddScan(&scan)
+	return scan, err
+}
+
+func (s *auditService) GetScans(sessionId uint64) []model.AuditScan {
+	return s.repo.GetScans(sessionId)
+}
+
+func (s *auditService) DeleteScanById(id uint64) {
+	s.repo.DeleteScanById(id)
+}
+
+// GetReconciliation sorts the expected and scanned items into found, missing,
+// unexpected and misplaced. An item scanned more than once counts once, at
+// the location of its last scan.
+func (s *auditService) GetReconciliation(sessionId uint64) (model.AuditReconciliation, error) {
+	session := s.repo.GetSessionById(sessionId)
+	if session.ID == 0 {
+		return model.AuditReconciliation{}, errors.New("audit session not found")
+	}
+	result := model.AuditReconciliation{
+		Session:    session,
+		Found:      []itemmodel.Item{},
+		Missing:    []itemmodel.Item{},
+		Unexpected: []itemmodel.Item{},
+		Unknown:    []string{},
+		Misplaced:  []model.MisplacedItem{},
+	}
+
+	scannedAt := map[uint64]*uint64{}
+	var scanOrder []uint64
+	for _, scan := range s.repo.GetScans(sessionId) {
+		if scan.ItemID == nil {
+			if !slices.Contains(result.Unknown, scan.Value) {
+				result.Unknown = append(result.Unknown, scan.Value)
+			}
+			continue
+		}
+		if _, seen := scannedAt[*scan.ItemID]; !seen {
+			scanOrder = append(scanOrder, *scan.ItemID)
+		}
+		scannedAt[*scan.ItemID] = scan.LocationID
+	}
+
+	expected := map[uint64]bool{}
+	for _, id := range s.repo.GetExpectedItemIds(sessionId) {
+		expected[id] = true
+		if _, scanned := scannedAt[id]; !scanned {
+			if item := s.itemService.GetItemById(id); item.ID != 0 {
+				result.Missing = append(result.Missing, item)
+			}
+		}
+	}
+
+	subtrees := map[uint64][]uint64{}
+	for _, id := range scanOrder {
+		item := s.itemService.GetItemById(id)
+		if item.ID == 0 {
+			continue
+		}
+		if expected[id] {
+			result.Found = append(result.Found, item)
+		} else {
+			result.Unexpected = append(result.Unexpected, item)
+		}
+		location := scannedAt[id]
+		if location == nil {
+			continue
+		}
+		if _, ok := subtrees[*location]; !ok {
+			subtrees[*location] = s.repo.GetLocationSubtreeIds(*location)
+		}
+		if item.LocationID == nil || !slices.Contains(subtrees[*location], *item.LocationID) {
+			result.Misplaced = append(result.Misplaced, model.MisplacedItem{Item: item, ScannedLocationID: *location})
+		}
+	}
+	return result, nil
+}
+
+// ApplyResult makes the changes chosen from a reconciliation: missing items
+// and recovered Lost items change status through the lifecycle, misplaced
+// items move to where they were scanned.
+func (s *auditService) ApplyResult(sessionId uint64, actions model.AuditActions) (model.AuditApplyResult, error) {
+	reconciliation, err := s.GetReconciliation(sessionId)
+	if err != nil {
+		return model.AuditApplyResult{}, err
+	}
+	result := model.AuditApplyResult{Errors: []model.AuditApplyError{}}
+	fail := func(item itemmodel.Item, err error) {
+		result.Errors = append(result.Errors, model.AuditApplyError{AssetNo: item.AssetNo, Message: err.Error()})
+	}
+	note := fmt.Sprintf("Audit: %s", reconciliation.Session.Name)
+
+	if actions.MissingStatus != "" {
+		for _, item := range reconciliation.Missing {
+			if item.AssetStatus == actions.MissingStatus {
+				continue
+			}
+			if err := s.itemService.TransitionStatus(item.ID, actions.MissingStatus, actions.Fields, note+", not found"); err != nil {
+				fail(item, err)
+				continue
+			}
+			result.Updated++
+		}
+	}
+	if actions.RecoveredStatus != "" {
+		for _, item := range append(reconciliation.Found, reconciliation.Unexpected...) {
+			if item.AssetStatus != itemmodel.LOST {
+				continue
+			}
+			if err := s.itemService.TransitionStatus(item.ID, actions.RecoveredStatus, actions.Fields, note+", found again"); err != nil {
+				fail(item, err)
+				continue
+			}
+			result.Updated++
+		}
+	}
+	if actions.RelocateMisplaced {
+		for _, misplaced := range reconciliation.Misplaced {
+			if err := s.relocate(misplaced, note); err != nil {
+				fail(misplaced.Item, err)
+				continue
+			}
+			result.Updated++
+		}
+	}
+	return result, nil
+}
+
+// relocate moves a misplaced item to where it was scanned and notes the move
+// in its status history, which keeps the status as it is.
+func (s *auditService) relocate(misplaced model.MisplacedItem, note string) error {
+	if err := s.locationService.SetItemLocation(misplaced.Item.ID, &misplaced.ScannedLocationID); err != nil {
+		return err
+	}
+	item := s.itemService.GetItemById(misplaced.Item.ID)
+	location := s.locationService.GetLocationById(misplaced.ScannedLocationID)
+	return s.lifecycleService.RecordTransition(lifecyclemodel.StatusHistory{
+		ItemID:     item.ID,
+		FromStatus: item.AssetStatus,
+		ToStatus:   item.AssetStatus,
+		Note:       note + ", moved to " + location.Name,
+	})
+}
@@ -0,0 +1,6 @@
+package repository
+
+type BackupRepository interface {
+	SnapshotDatabase(path string) error
+	GetDataDir() string
+}
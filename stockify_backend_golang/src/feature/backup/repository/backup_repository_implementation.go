@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/common/db"
+)
+
+type backupRepository struct{}
+
+func BackupRepositoryImplementation() BackupRepository {
+	return &backupRepository{}
+}
+
+// SnapshotDatabase writes a consistent copy of the database to path, which
+// must not exist yet.
+func (r *backupRepository) SnapshotDatabase(path string) error {
+	return db.DB.Exec("VACUUM INTO ?", path).Error
+}
+
+func (r *backupRepository) GetDataDir() string {
+	return db.DataDir
+}
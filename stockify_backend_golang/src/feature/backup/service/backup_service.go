@@ -0,0 +1,5 @@
+package service
+
+type BackupService interface {
+	CreateBackup(outPath string) error
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	attachmentrepository "stockify_backend_golang/src/feature/attachment/repository"
+	"stockify_backend_golang/src/feature/backup/repository"
+)
+
+// databaseEntry is the name of the database inside a backup
+const databaseEntry = "inventory.db"
+
+type backupService struct {
+	repo repository.BackupRepository
+}
+
+func BackupServiceImplementation(repo repository.BackupRepository) BackupService {
+	return &backupService{repo: repo}
+}
+
+// CreateBackup writes a zip archive to outPath holding a snapshot of the
+// database as inventory.db and the attachment files under attachments/, laid
+// out as in the data directory so a backup is restored by unpacking it there.
+func (s *backupService) CreateBackup(outPath string) error {
+	if outPath == "" {
+		return errors.New("output path is required")
+	}
+	tmpDir, err := os.MkdirTemp("", "stockify-backup-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	snapshot := filepath.Join(tmpDir, databaseEntry)
+	if err := s.repo.SnapshotDatabase(snapshot); err != nil {
+		return err
+	}
+
+	out, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+	archive := zip.NewWriter(out)
+	err = addFile(archive, databaseEntry, snapshot)
+	if err == nil {
+		err = addDir(archive, s.repo.GetDataDir(), attachmentrepository.AttachmentsDir())
+	}
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(outPath)
+	}
+	return err
+}
+
+// addDir adds the files below dir, named by their path relative to base.
+// A missing dir adds nothing, and uploads still in progress are left out.
+func addDir(archive *zip.Writer, base, dir string) error {
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() || attachmentrepository.IsPartialUpload(path) {
+			return err
+		}
+		name, err := filepath.Rel(base, path)
+		if err != nil {
+			return err
+		}
+		return addFile(archive, filepath.ToSlash(name), path)
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func addFile(archive *zip.Writer, name, path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+	dst, err := archive.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	return err
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ComponentMove records a component being built into, moved between or taken
+// out of parent items. A nil parent means the component stood on its own.
+type ComponentMove struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID       uint64    `gorm:"index" json:"ItemID"`
+	FromParentID *uint64   `json:"FromParentID,omitempty"`
+	ToParentID   *uint64   `json:"ToParentID,omitempty"`
+	Note         string    `json:"Note"`
+	MovedAt      time.Time `json:"MovedAt"`
+}
+
+func (m *ComponentMove) String() string {
+	return fmt.Sprintf("ComponentMove{ID: %d, ItemID: %d, MovedAt: %s}", m.ID, m.ItemID, m.MovedAt.Format(time.DateTime))
+}
@@ -0,0 +1,9 @@
+package model
+
+import itemmodel "stockify_backend_golang/src/feature/item/model"
+
+// ComponentNode is an item with the components built into it
+type ComponentNode struct {
+	Item       itemmodel.Item  `json:"Item"`
+	Components []ComponentNode `json:"Components"`
+}
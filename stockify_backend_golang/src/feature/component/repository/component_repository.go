@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/component/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+)
+
+type ComponentRepository interface {
+	GetChildren(parentId uint64) []itemmodel.Item
+	AddMove(move model.ComponentMove) error
+	GetMovesByItemId(itemId uint64) []model.ComponentMove
+}
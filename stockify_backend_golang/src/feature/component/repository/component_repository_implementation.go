@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/component/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.ComponentMove{})
+	if err != nil {
+		log.Fatal("Failed to migrate ComponentMove table: " + err.Error())
+	}
+}
+
+// ComponentsQuery selects the ids of every item built into an item, at any
+// depth but not the item itself; the single argument is the item id.
+const ComponentsQuery = `WITH RECURSIVE inside(id) AS (
+	SELECT id FROM items WHERE parent_id = ? AND deleted_at IS NULL
+	UNION ALL
+	SELECT items.id FROM items JOIN inside ON items.parent_id = inside.id
+	WHERE items.deleted_at IS NULL
+) SELECT id FROM inside`
+
+type componentRepository struct{}
+
+func ComponentRepositoryImplementation() ComponentRepository {
+	return &componentRepository{}
+}
+
+func (r *componentRepository) GetChildren(parentId uint64) []itemmodel.Item {
+	var items []itemmodel.Item
+	db.DB.Where("parent_id = ?", parentId).Order("asset_no").Find(&items)
+	return items
+}
+
+func (r *componentRepository) AddMove(move model.ComponentMove) error {
+	return db.DB.Create(&move).Error
+}
+
+func (r *componentRepository) GetMovesByItemId(itemId uint64) []model.ComponentMove {
+	var moves []model.ComponentMove
+	db.DB.Where("item_id = ?", itemId).Order("moved_at DESC, id DESC").Find(&moves)
+	return moves
+}
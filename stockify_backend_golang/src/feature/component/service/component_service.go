@@ -0,0 +1,14 @@
+package service
+
+import (
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/component/model"
+)
+
+type ComponentService interface {
+	AttachComponent(itemId uint64, parentId uint64, note string) error
+	DetachComponent(itemId uint64, note string) error
+	GetComponentTree(parentId uint64) (model.ComponentNode, error)
+	GetComponentHistory(itemId uint64) []model.ComponentMove
+	HandleEvent(e event.Event)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/component/model"
+	"stockify_backend_golang/src/feature/component/repository"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"strings"
+	"time"
+)
+
+type componentService struct {
+	repo        repository.ComponentRepository
+	itemService itemservice.ItemService
+}
+
+func ComponentServiceImplementation(repo repository.ComponentRepository, itemService itemservice.ItemService) ComponentService {
+	return &componentService{repo: repo, itemService: itemService}
+}
+
+// AttachComponent builds an item into a parent item, moving it out of its
+// current parent if it has one. The component takes over the parent's
+// assignee, and so do its own components.
+func (s *componentService) AttachComponent(itemId uint64, parentId uint64, note string) error {
+	item := s.itemService.GetItemById(itemId)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	parent := s.itemService.GetItemById(parentId)
+	if parent.ID == 0 {
+		return errors.New("parent item not found")
+	}
+	if item.ID == parent.ID {
+		return errors.New("an item cannot be a component of itself")
+	}
+	// Walk up from the new parent; meeting the item means it would end up inside itself
+	for ancestor := parent.ParentID; ancestor != nil; {
+		if *ancestor == item.ID {
+			return errors.New("an item cannot be a component of one of its own components")
+		}
+		ancestor = s.itemService.GetItemById(*ancestor).ParentID
+	}
+	if item.ParentID != nil && *item.ParentID == parent.ID {
+		return nil
+	}
+
+	from := item.ParentID
+	item.ParentID = &parent.ID
+	item.AssignedToID = parent.AssignedToID
+	item.AssignedTo = nil
+	if err := s.itemService.UpdateItem(item); err != nil {
+		return err
+	}
+	return s.recordMove(item.ID, from, &parent.ID, note)
+}
+
+// DetachComponent takes an item out of its parent. It keeps its assignee.
+func (s *componentService) DetachComponent(itemId uint64, note string) error {
+	item := s.itemService.GetItemById(itemId)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	if item.ParentID == nil {
+		return errors.New("item is not a component of another item")
+	}
+	from := item.ParentID
+	item.ParentID = nil
+	item.AssignedTo = nil
+	if err := s.itemService.UpdateItem(item); err != nil {
+		return err
+	}
+	return s.recordMove(item.ID, from, nil, note)
+}
+
+// GetComponentTree returns the item with everything built into it
+func (s *componentService) GetComponentTree(parentId uint64) (model.ComponentNode, error) {
+	parent := s.itemService.GetItemById(parentId)
+	if parent.ID == 0 {
+		return model.ComponentNode{}, errors.New("item not found")
+	}
+	return s.buildNode(parent), nil
+}
+
+func (s *componentService) GetComponentHistory(itemId uint64) []model.ComponentMove {
+	return s.repo.GetMovesByItemId(itemId)
+}
+
+// HandleEvent keeps components in step with their parent: a new assignee is
+// passed down to the components, and the components of a deleted item are
+// taken out of it.
+func (s *componentService) HandleEvent(e event.Event) {
+	payload, ok := e.Data.(itemmodel.ItemEvent)
+	if !ok {
+		return
+	}
+	switch e.Type {
+	case itemmodel.ITEM_ASSIGNEE_CHANGED:
+		for _, child := range s.repo.GetChildren(payload.Item.ID) {
+			if sameId(child.AssignedToID, payload.Item.AssignedToID) {
+				continue
+			}
+			child.AssignedToID = payload.Item.AssignedToID
+			// Each update publishes its own event, which carries the change further down
+			if err := s.itemService.UpdateItem(child); err != nil {
+				log.Println("Failed to pass assignee on to component", child.ID, ":", err)
+			}
+		}
+	case itemmodel.ITEM_DELETED:
+		for _, child := range s.repo.GetChildren(payload.Item.ID) {
+			if err := s.DetachComponent(child.ID, "Parent item deleted"); err != nil {
+				log.Println("Failed to detach component", child.ID, ":", err)
+			}
+		}
+	}
+}
+
+func (s *componentService) buildNode(item itemmodel.Item) model.ComponentNode {
+	node := model.ComponentNode{Item: item, Components: []model.ComponentNode{}}
+	for _, child := range s.repo.GetChildren(item.ID) {
+		node.Components = append(node.Components, s.buildNode(child))
+	}
+	return node
+}
+
+func (s *componentService) recordMove(itemId uint64, from, to *uint64, note string) error {
+	return s.repo.AddMove(model.ComponentMove{
+		ItemID:       itemId,
+		FromParentID: from,
+		ToParentID:   to,
+		Note:         strings.TrimSpace(note),
+		MovedAt:      time.Now(),
+	})
+}
+
+func sameId(a, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Consumable is stock kept by quantity rather than one row per unit, such as
+// toner, cables or mice. QuantityOnHand only changes through stock
+// transactions.
+type Consumable struct {
+	gorm.Model
+	ID               uint64  `gorm:"primaryKey;autoIncrement" json:"ID"`
+	SKU              string  `gorm:"uniqueIndex" json:"SKU"`
+	Name             string  `json:"Name"`
+	DeviceTypeID     *uint64 `gorm:"index" json:"DeviceTypeID,omitempty"`
+	Unit             string  `json:"Unit"`
+	QuantityOnHand   int     `json:"QuantityOnHand"`
+	ReorderThreshold int     `json:"ReorderThreshold"`
+	Notes            *string `json:"Notes,omitempty"`
+}
+
+// IsLowStock tells whether the consumable is at or below its reorder threshold
+func (c *Consumable) IsLowStock() bool {
+	return c.QuantityOnHand <= c.ReorderThreshold
+}
+
+func (c *Consumable) String() string {
+	return fmt.Sprintf("Consumable{ID: %d, SKU: %s, Name: %s, QuantityOnHand: %d}", c.ID, c.SKU, c.Name, c.QuantityOnHand)
+}
@@ -0,0 +1,12 @@
+package model
+
+import "stockify_backend_golang/src/common/event"
+
+// CONSUMABLE_LOW_STOCK is published when a transaction takes a consumable
+// from above its reorder threshold to at or below it.
+const CONSUMABLE_LOW_STOCK event.Type = "consumable.low_stock"
+
+type ConsumableEvent struct {
+	Consumable  Consumable       `json:"consumable"`
+	Transaction StockTransaction `json:"transaction"`
+}
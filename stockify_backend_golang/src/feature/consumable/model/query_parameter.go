@@ -0,0 +1,7 @@
+package model
+
+type ConsumableQueryParams struct {
+	Search       string
+	DeviceTypeID *uint64
+	LowStockOnly bool
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+type StockTransactionKind string
+
+const (
+	STOCK_IN     StockTransactionKind = "in"
+	STOCK_OUT    StockTransactionKind = "out"
+	STOCK_ADJUST StockTransactionKind = "adjust"
+)
+
+// StockTransaction is one movement of a consumable. Quantity is the amount
+// moved for in and out, and the counted quantity for an adjustment. UserID is
+// who the stock was handed to, or who received it.
+type StockTransaction struct {
+	ID            uint64               `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ConsumableID  uint64               `gorm:"index" json:"ConsumableID"`
+	Kind          StockTransactionKind `json:"Kind"`
+	Quantity      int                  `json:"Quantity"`
+	QuantityAfter int                  `json:"QuantityAfter"`
+	UserID        *uint64              `gorm:"index" json:"UserID,omitempty"`
+	Note          string               `json:"Note"`
+	OccurredAt    time.Time            `gorm:"index" json:"OccurredAt"`
+}
+
+func (t *StockTransaction) String() string {
+	return fmt.Sprintf("StockTransaction{ID: %d, ConsumableID: %d, Kind: %s, Quantity: %d}", t.ID, t.ConsumableID, t.Kind, t.Quantity)
+}
+
+// Delta is the change in quantity on hand the transaction makes from before
+func (t *StockTransaction) Delta(before int) int {
+	switch t.Kind {
+	case STOCK_IN:
+		return t.Quantity
+	case STOCK_OUT:
+		return -t.Quantity
+	default:
+		return t.Quantity - before
+	}
+}
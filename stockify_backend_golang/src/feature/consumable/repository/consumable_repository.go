@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/consumable/model"
+)
+
+type ConsumableRepository interface {
+	GetAllConsumables() []model.Consumable
+	GetConsumableById(id uint64) model.Consumable
+	GetConsumableBySKU(sku string) model.Consumable
+	AddConsumable(consumable *model.Consumable) error
+	UpdateConsumable(consumable model.Consumable) error
+	DeleteConsumableById(id uint64)
+	GetFilteredConsumables(params model.ConsumableQueryParams) ([]model.Consumable, error)
+	ApplyTransaction(transaction *model.StockTransaction) (before, after model.Consumable, err error)
+	GetTransactions(consumableId uint64, userId uint64) []model.StockTransaction
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/consumable/model"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Consumable{}, &model.StockTransaction{})
+	if err != nil {
+		log.Fatal("Failed to migrate Consumable tables: " + err.Error())
+	}
+}
+
+type consumableRepository struct{}
+
+func ConsumableRepositoryImplementation() ConsumableRepository {
+	return &consumableRepository{}
+}
+
+func (r *consumableRepository) GetAllConsumables() []model.Consumable {
+	var consumables []model.Consumable
+	db.DB.Order("name").Find(&consumables)
+	return consumables
+}
+
+func (r *consumableRepository) GetConsumableById(id uint64) model.Consumable {
+	var consumable model.Consumable
+	db.DB.First(&consumable, id)
+	return consumable
+}
+
+func (r *consumableRepository) GetConsumableBySKU(sku string) model.Consumable {
+	var consumable model.Consumable
+	db.DB.Where("sku = ?", sku).First(&consumable)
+	return consumable
+}
+
+func (r *consumableRepository) AddConsumable(consumable *model.Consumable) error {
+	return db.DB.Create(consumable).Error
+}
+
+// UpdateConsumable saves everything but the quantity on hand, which belongs
+// to the stock transactions.
+func (r *consumableRepository) UpdateConsumable(consumable model.Consumable) error {
+	return db.DB.Omit("QuantityOnHand").Save(&consumable).Error
+}
+
+// DeleteConsumableById frees the SKU; the stock transactions of the
+// consumable stay in the users' history.
+func (r *consumableRepository) DeleteConsumableById(id uint64) {
+	db.HardDelete(&model.Consumable{}, id)
+}
+
+func (r *consumableRepository) GetFilteredConsumables(params model.ConsumableQueryParams) ([]model.Consumable, error) {
+	query := db.DB.Model(&model.Consumable{})
+	if params.Search != "" {
+		search := "%" + params.Search + "%"
+		query = query.Where("LOWER(sku) LIKE LOWER(?) OR LOWER(name) LIKE LOWER(?)", search, search)
+	}
+	if params.DeviceTypeID != nil && *params.DeviceTypeID != 0 {
+		query = query.Where("device_type_id = ?", *params.DeviceTypeID)
+	}
+	if params.LowStockOnly {
+		query = query.Where("quantity_on_hand <= reorder_threshold")
+	}
+	var consumables []model.Consumable
+	err := query.Order("name").Find(&consumables).Error
+	if err != nil {
+		return nil, err
+	}
+	return consumables, nil
+}
+
+// ApplyTransaction stores the transaction and moves the quantity on hand in
+// one database transaction, refusing to take it below zero.
+func (r *consumableRepository) ApplyTransaction(transaction *model.StockTransaction) (before, after model.Consumable, err error) {
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&before, transaction.ConsumableID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("consumable not found")
+			}
+			return err
+		}
+		quantity := before.QuantityOnHand + transaction.Delta(before.QuantityOnHand)
+		if quantity < 0 {
+			return fmt.Errorf("only %d %s of %s on hand", before.QuantityOnHand, before.Unit, before.Name)
+		}
+		err := tx.Model(&model.Consumable{}).Where("id = ?", before.ID).Update("quantity_on_hand", quantity).Error
+		if err != nil {
+			return err
+		}
+		transaction.QuantityAfter = quantity
+		if err := tx.Create(transaction).Error; err != nil {
+			return err
+		}
+		after = before
+		after.QuantityOnHand = quantity
+		return nil
+	})
+	return before, after, err
+}
+
+// GetTransactions returns the newest transactions first, of one consumable
+// and/or one user when the ids are not 0.
+func (r *consumableRepository) GetTransactions(consumableId uint64, userId uint64) []model.StockTransaction {
+	query := db.DB.Model(&model.StockTransaction{})
+	if consumableId != 0 {
+		query = query.Where("consumable_id = ?", consumableId)
+	}
+	if userId != 0 {
+		query = query.Where("user_id = ?", userId)
+	}
+	var transactions []model.StockTransaction
+	query.Order("occurred_at DESC, id DESC").Find(&transactions)
+	return transactions
+}
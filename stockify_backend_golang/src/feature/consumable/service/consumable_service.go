@@ -0,0 +1,16 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/consumable/model"
+)
+
+type ConsumableService interface {
+	GetAllConsumables() []model.Consumable
+	GetConsumableById(id uint64) model.Consumable
+	GetFilteredConsumables(params model.ConsumableQueryParams) ([]model.Consumable, error)
+	AddConsumable(consumable model.Consumable) error
+	UpdateConsumable(consumable model.Consumable) error
+	DeleteConsumableById(id uint64)
+	RecordTransaction(transaction model.StockTransaction) (model.Consumable, error)
+	GetTransactions(consumableId uint64, userId uint64) []model.StockTransaction
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/consumable/model"
+	"stockify_backend_golang/src/feature/consumable/repository"
+	devicetyperepository "stockify_backend_golang/src/feature/devicetype/repository"
+	userrepository "stockify_backend_golang/src/feature/user/repository"
+	"strings"
+	"time"
+)
+
+const defaultUnit = "pcs"
+
+type consumableService struct {
+	repo           repository.ConsumableRepository
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository
+	userRepo       userrepository.UserRepository
+}
+
+func ConsumableServiceImplementation(
+	repo repository.ConsumableRepository,
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository,
+	userRepo userrepository.UserRepository,
+) ConsumableService {
+	return &consumableService{repo: repo, deviceTypeRepo: deviceTypeRepo, userRepo: userRepo}
+}
+
+func (s *consumableService) GetAllConsumables() []model.Consumable {
+	return s.repo.GetAllConsumables()
+}
+
+func (s *consumableService) GetConsumableById(id uint64) model.Consumable {
+	return s.repo.GetConsumableById(id)
+}
+
+func (s *consumableService) GetFilteredConsumables(params model.ConsumableQueryParams) ([]model.Consumable, error) {
+	return s.repo.GetFilteredConsumables(params)
+}
+
+// AddConsumable creates the consumable empty and books any starting quantity
+// as a stock-in, so the transactions always add up to the quantity on hand.
+func (s *consumableService) AddConsumable(consumable model.Consumable) error {
+	if err := s.validateConsumable(&consumable); err != nil {
+		return err
+	}
+	initial := consumable.QuantityOnHand
+	if initial < 0 {
+		return errors.New("quantity on hand cannot be negative")
+	}
+	consumable.QuantityOnHand = 0
+	if err := s.repo.AddConsumable(&consumable); err != nil {
+		return err
+	}
+	if initial == 0 {
+		return nil
+	}
+	_, err := s.RecordTransaction(model.StockTransaction{
+		ConsumableID: consumable.ID,
+		Kind:         model.STOCK_IN,
+		Quantity:     initial,
+		Note:         "Initial stock",
+	})
+	return err
+}
+
+func (s *consumableService) UpdateConsumable(consumable model.Consumable) error {
+	existing := s.repo.GetConsumableById(consumable.ID)
+	if existing.ID == 0 {
+		return errors.New("consumable not found")
+	}
+	if err := s.validateConsumable(&consumable); err != nil {
+		return err
+	}
+	consumable.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateConsumable(consumable)
+}
+
+func (s *consumableService) DeleteConsumableById(id uint64) {
+	s.repo.DeleteConsumableById(id)
+}
+
+// RecordTransaction books stock in, out or a counted adjustment and returns
+// the consumable with its new quantity. Stock going out must name the user
+// it was handed to.
+func (s *consumableService) RecordTransaction(transaction model.StockTransaction) (model.Consumable, error) {
+	switch transaction.Kind {
+	case model.STOCK_IN, model.STOCK_OUT:
+		if transaction.Quantity <= 0 {
+			return model.Consumable{}, errors.New("quantity must be at least 1")
+		}
+	case model.STOCK_ADJUST:
+		if transaction.Quantity < 0 {
+			return model.Consumable{}, errors.New("counted quantity cannot be negative")
+		}
+	default:
+		return model.Consumable{}, fmt.Errorf("unknown stock transaction kind %q", transaction.Kind)
+	}
+	if transaction.Kind == model.STOCK_OUT && transaction.UserID == nil {
+		return model.Consumable{}, errors.New("stock going out needs the user it is handed to")
+	}
+	if transaction.UserID != nil && s.userRepo.GetUserById(*transaction.UserID).ID == 0 {
+		return model.Consumable{}, errors.New("user not found")
+	}
+	transaction.ID = 0
+	transaction.Note = strings.TrimSpace(transaction.Note)
+	if transaction.OccurredAt.IsZero() {
+		transaction.OccurredAt = time.Now()
+	}
+
+	before, after, err := s.repo.ApplyTransaction(&transaction)
+	if err != nil {
+		return model.Consumable{}, err
+	}
+	if after.IsLowStock() && !before.IsLowStock() {
+		event.Publish(model.CONSUMABLE_LOW_STOCK, model.ConsumableEvent{Consumable: after, Transaction: transaction})
+	}
+	return after, nil
+}
+
+func (s *consumableService) GetTransactions(consumableId uint64, userId uint64) []model.StockTransaction {
+	return s.repo.GetTransactions(consumableId, userId)
+}
+
+func (s *consumableService) validateConsumable(consumable *model.Consumable) error {
+	consumable.SKU = strings.TrimSpace(consumable.SKU)
+	consumable.Name = strings.TrimSpace(consumable.Name)
+	consumable.Unit = strings.TrimSpace(consumable.Unit)
+	if consumable.SKU == "" {
+		return errors.New("SKU is required")
+	}
+	if consumable.Name == "" {
+		return errors.New("consumable name is required")
+	}
+	if other := s.repo.GetConsumableBySKU(consumable.SKU); other.ID != 0 && other.ID != consumable.ID {
+		return fmt.Errorf("SKU %s is already used by %s", consumable.SKU, other.Name)
+	}
+	if consumable.DeviceTypeID != nil && s.deviceTypeRepo.GetDeviceTypeById(*consumable.DeviceTypeID).ID == 0 {
+		return errors.New("device type not found")
+	}
+	if consumable.ReorderThreshold < 0 {
+		return errors.New("reorder threshold cannot be negative")
+	}
+	if consumable.Unit == "" {
+		consumable.Unit = defaultUnit
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// AttributeDefinition declares a typed custom field for items of one device
+// type, e.g. "tonerModel" for printers or "screenSize" for monitors.
+type AttributeDefinition struct {
+	gorm.Model
+	ID           uint64        `gorm:"primaryKey;autoIncrement" json:"ID"`
+	DeviceTypeID uint64        `gorm:"uniqueIndex:idx_attribute_definition_type_key" json:"DeviceTypeID"`
+	Key          string        `gorm:"uniqueIndex:idx_attribute_definition_type_key" json:"Key"`
+	Label        string        `json:"Label"`
+	Type         AttributeType `json:"Type"`
+	Required     bool          `json:"Required"`
+	Options      []string      `gorm:"serializer:json" json:"Options,omitempty"`
+	SortOrder    int           `json:"SortOrder"`
+}
+
+func (d *AttributeDefinition) String() string {
+	return fmt.Sprintf("AttributeDefinition{ID: %d, DeviceTypeID: %d, Key: %s, Type: %s, Required: %t}",
+		d.ID, d.DeviceTypeID, d.Key, d.Type, d.Required)
+}
@@ -0,0 +1,19 @@
+package model
+
+type AttributeType string
+
+const (
+	STRING  AttributeType = "string"
+	NUMBER  AttributeType = "number"
+	DATE    AttributeType = "date"
+	ENUM    AttributeType = "enum"
+	BOOLEAN AttributeType = "boolean"
+)
+
+func (t AttributeType) IsValid() bool {
+	switch t {
+	case STRING, NUMBER, DATE, ENUM, BOOLEAN:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package model
+
+// ItemAttributeValue stores one custom field value of an item. Value holds the
+// normalized text form (dates as YYYY-MM-DD, booleans as true/false) and
+// NumberValue the parsed number so number attributes compare numerically.
+type ItemAttributeValue struct {
+	ID           uint64        `gorm:"primaryKey;autoIncrement" json:"-"`
+	ItemID       uint64        `gorm:"index" json:"-"`
+	DefinitionID uint64        `gorm:"index" json:"DefinitionID"`
+	Key          string        `gorm:"index" json:"Key"`
+	Type         AttributeType `json:"Type"`
+	Value        string        `json:"Value"`
+	NumberValue  *float64      `json:"-"`
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/customfield/model"
+)
+
+type CustomFieldRepository interface {
+	GetDefinitionsByDeviceTypeId(deviceTypeId uint64) []model.AttributeDefinition
+	GetDefinitionById(id uint64) model.AttributeDefinition
+	GetDefinitionByKey(deviceTypeId uint64, key string) model.AttributeDefinition
+	AddDefinition(definition model.AttributeDefinition) error
+	UpdateDefinition(definition model.AttributeDefinition) error
+	DeleteDefinitionById(id uint64) error
+	GetValuesByItemId(itemId uint64) []model.ItemAttributeValue
+	ReplaceItemValues(itemId uint64, values []model.ItemAttributeValue) error
+	DeleteItemValues(itemId uint64) error
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/customfield/model"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.AttributeDefinition{}, &model.ItemAttributeValue{})
+	if err != nil {
+		log.Fatal("Failed to migrate custom field tables: " + err.Error())
+	}
+}
+
+type customFieldRepository struct{}
+
+func CustomFieldRepositoryImplementation() CustomFieldRepository {
+	return &customFieldRepository{}
+}
+
+func (r *customFieldRepository) GetDefinitionsByDeviceTypeId(deviceTypeId uint64) []model.AttributeDefinition {
+	var definitions []model.AttributeDefinition
+	db.DB.Where("device_type_id = ?", deviceTypeId).Order("sort_order, label").Find(&definitions)
+	return definitions
+}
+
+func (r *customFieldRepository) GetDefinitionById(id uint64) model.AttributeDefinition {
+	var definition model.AttributeDefinition
+	db.DB.First(&definition, id)
+	return definition
+}
+
+func (r *customFieldRepository) GetDefinitionByKey(deviceTypeId uint64, key string) model.AttributeDefinition {
+	var definition model.AttributeDefinition
+	db.DB.Where("device_type_id = ? AND key = ?", deviceTypeId, key).Limit(1).Find(&definition)
+	return definition
+}
+
+func (r *customFieldRepository) AddDefinition(definition model.AttributeDefinition) error {
+	return db.DB.Create(&definition).Error
+}
+
+func (r *customFieldRepository) UpdateDefinition(definition model.AttributeDefinition) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&definition).Error; err != nil {
+			return err
+		}
+		// Keep the denormalized key and type on stored values in step
+		return tx.Model(&model.ItemAttributeValue{}).
+			Where("definition_id = ?", definition.ID).
+			Updates(map[string]interface{}{"key": definition.Key, "type": definition.Type}).Error
+	})
+}
+
+// DeleteDefinitionById removes the definition for good, together with every
+// value stored for it, so that its key can be defined again later.
+func (r *customFieldRepository) DeleteDefinitionById(id uint64) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("definition_id = ?", id).Delete(&model.ItemAttributeValue{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&model.AttributeDefinition{}, id).Error
+	})
+}
+
+func (r *customFieldRepository) GetValuesByItemId(itemId uint64) []model.ItemAttributeValue {
+	var values []model.ItemAttributeValue
+	db.DB.Where("item_id = ?", itemId).Order("key").Find(&values)
+	return values
+}
+
+func (r *customFieldRepository) ReplaceItemValues(itemId uint64, values []model.ItemAttributeValue) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("item_id = ?", itemId).Delete(&model.ItemAttributeValue{}).Error; err != nil {
+			return err
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		return tx.Create(&values).Error
+	})
+}
+
+func (r *customFieldRepository) DeleteItemValues(itemId uint64) error {
+	return db.DB.Where("item_id = ?", itemId).Delete(&model.ItemAttributeValue{}).Error
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"stockify_backend_golang/src/feature/customfield/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var attributeKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+func validateDefinition(definition model.AttributeDefinition) error {
+	if !attributeKeyPattern.MatchString(definition.Key) {
+		return fmt.Errorf("attribute key %q must start with a letter and contain only letters, digits and underscores", definition.Key)
+	}
+	if !definition.Type.IsValid() {
+		return fmt.Errorf("unknown attribute type %q", definition.Type)
+	}
+	if definition.Type == model.ENUM && len(definition.Options) == 0 {
+		return fmt.Errorf("enum attribute %q needs at least one option", definition.Key)
+	}
+	return nil
+}
+
+// ValidateValues checks raw values against the definitions of a device type
+// and returns them normalized for storage. Empty values are treated as unset.
+func ValidateValues(definitions []model.AttributeDefinition, raw map[string]string) ([]model.ItemAttributeValue, error) {
+	byKey := map[string]model.AttributeDefinition{}
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+	for key := range raw {
+		if _, ok := byKey[key]; !ok {
+			return nil, fmt.Errorf("attribute %q is not defined for this device type", key)
+		}
+	}
+
+	var values []model.ItemAttributeValue
+	for _, definition := range definitions {
+		text := strings.TrimSpace(raw[definition.Key])
+		if text == "" {
+			if definition.Required {
+				return nil, fmt.Errorf("attribute %q is required", definition.Key)
+			}
+			continue
+		}
+		value, err := normalizeValue(definition, text)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func normalizeValue(definition model.AttributeDefinition, text string) (model.ItemAttributeValue, error) {
+	value := model.ItemAttributeValue{
+		DefinitionID: definition.ID,
+		Key:          definition.Key,
+		Type:         definition.Type,
+	}
+	switch definition.Type {
+	case model.NUMBER:
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return value, fmt.Errorf("attribute %q must be a number", definition.Key)
+		}
+		value.Value = strconv.FormatFloat(number, 'f', -1, 64)
+		value.NumberValue = &number
+	case model.DATE:
+		date, err := parseDate(text)
+		if err != nil {
+			return value, fmt.Errorf("attribute %q must be a date (YYYY-MM-DD)", definition.Key)
+		}
+		value.Value = date.Format(time.DateOnly)
+	case model.ENUM:
+		for _, option := range definition.Options {
+			if strings.EqualFold(option, text) {
+				value.Value = option
+				return value, nil
+			}
+		}
+		return value, fmt.Errorf("attribute %q must be one of %s", definition.Key, strings.Join(definition.Options, ", "))
+	case model.BOOLEAN:
+		switch strings.ToLower(text) {
+		case "true", "yes", "1":
+			value.Value = "true"
+		case "false", "no", "0":
+			value.Value = "false"
+		default:
+			return value, fmt.Errorf("attribute %q must be true or false", definition.Key)
+		}
+	default:
+		value.Value = text
+	}
+	return value, nil
+}
+
+func parseDate(text string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, text); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, text)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/customfield/model"
+)
+
+type CustomFieldService interface {
+	GetDefinitionsByDeviceTypeId(deviceTypeId uint64) []model.AttributeDefinition
+	AddDefinition(definition model.AttributeDefinition) error
+	UpdateDefinition(definition model.AttributeDefinition) error
+	DeleteDefinitionById(id uint64) error
+	GetItemAttributes(itemId uint64) []model.ItemAttributeValue
+	SetItemAttributes(itemId uint64, values map[string]string) error
+	HandleEvent(e event.Event)
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/customfield/model"
+	"stockify_backend_golang/src/feature/customfield/repository"
+	devicetyperepository "stockify_backend_golang/src/feature/devicetype/repository"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemrepository "stockify_backend_golang/src/feature/item/repository"
+	"strings"
+)
+
+type customFieldService struct {
+	repo           repository.CustomFieldRepository
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository
+	itemRepo       itemrepository.ItemRepository
+}
+
+func CustomFieldServiceImplementation(
+	repo repository.CustomFieldRepository,
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository,
+	itemRepo itemrepository.ItemRepository,
+) CustomFieldService {
+	return &customFieldService{repo: repo, deviceTypeRepo: deviceTypeRepo, itemRepo: itemRepo}
+}
+
+func (s *customFieldService) GetDefinitionsByDeviceTypeId(deviceTypeId uint64) []model.AttributeDefinition {
+	return s.repo.GetDefinitionsByDeviceTypeId(deviceTypeId)
+}
+
+func (s *customFieldService) AddDefinition(definition model.AttributeDefinition) error {
+	normalizeDefinition(&definition)
+	if err := validateDefinition(definition); err != nil {
+		return err
+	}
+	if s.deviceTypeRepo.GetDeviceTypeById(definition.DeviceTypeID).ID == 0 {
+		return errors.New("device type not found")
+	}
+	if s.repo.GetDefinitionByKey(definition.DeviceTypeID, definition.Key).ID != 0 {
+		return fmt.Errorf("attribute %q is already defined for this device type", definition.Key)
+	}
+	return s.repo.AddDefinition(definition)
+}
+
+// UpdateDefinition may rename, relabel or change options and requiredness,
+// but not the type or device type, since stored values depend on both.
+// Values already stored are kept as they are: making an attribute required
+// or dropping an option only holds up an item once its attributes are
+// edited again.
+func (s *customFieldService) UpdateDefinition(definition model.AttributeDefinition) error {
+	normalizeDefinition(&definition)
+	existing := s.repo.GetDefinitionById(definition.ID)
+	if existing.ID == 0 {
+		return errors.New("attribute definition not found")
+	}
+	if definition.Type != existing.Type || definition.DeviceTypeID != existing.DeviceTypeID {
+		return errors.New("the type and device type of an attribute cannot be changed; delete it and define a new one")
+	}
+	if err := validateDefinition(definition); err != nil {
+		return err
+	}
+	if other := s.repo.GetDefinitionByKey(definition.DeviceTypeID, definition.Key); other.ID != 0 && other.ID != definition.ID {
+		return fmt.Errorf("attribute %q is already defined for this device type", definition.Key)
+	}
+	definition.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateDefinition(definition)
+}
+
+func (s *customFieldService) DeleteDefinitionById(id uint64) error {
+	return s.repo.DeleteDefinitionById(id)
+}
+
+func (s *customFieldService) GetItemAttributes(itemId uint64) []model.ItemAttributeValue {
+	return s.repo.GetValuesByItemId(itemId)
+}
+
+// SetItemAttributes replaces every custom attribute value of the item after
+// validating them against the definitions of the item's device type.
+func (s *customFieldService) SetItemAttributes(itemId uint64, values map[string]string) error {
+	item := s.itemRepo.GetItemById(itemId)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	var deviceTypeId uint64
+	if item.DeviceTypeID != nil {
+		deviceTypeId = *item.DeviceTypeID
+	} else {
+		deviceTypeId = s.deviceTypeRepo.GetDeviceTypeByCode(string(item.DeviceType)).ID
+	}
+	normalized, err := ValidateValues(s.repo.GetDefinitionsByDeviceTypeId(deviceTypeId), values)
+	if err != nil {
+		return err
+	}
+	for i := range normalized {
+		normalized[i].ItemID = itemId
+	}
+	return s.repo.ReplaceItemValues(itemId, normalized)
+}
+
+// HandleEvent drops the attribute values of a deleted item
+func (s *customFieldService) HandleEvent(e event.Event) {
+	payload, ok := e.Data.(itemmodel.ItemEvent)
+	if !ok || e.Type != itemmodel.ITEM_DELETED {
+		return
+	}
+	if err := s.repo.DeleteItemValues(payload.Item.ID); err != nil {
+		log.Println("Failed to delete attribute values of item", payload.Item.ID, ":", err)
+	}
+}
+
+func normalizeDefinition(definition *model.AttributeDefinition) {
+	definition.Key = strings.TrimSpace(definition.Key)
+	definition.Label = strings.TrimSpace(definition.Label)
+	if definition.Label == "" {
+		definition.Label = definition.Key
+	}
+	var options []string
+	for _, option := range definition.Options {
+		if option = strings.TrimSpace(option); option != "" {
+			options = append(options, option)
+		}
+	}
+	definition.Options = options
+}
@@ -0,0 +1,27 @@
+package model
+
+const (
+	DefaultExpiringWithinDays = 30
+	DefaultReceivedMonths     = 12
+	DefaultTopUsers           = 5
+)
+
+type DashboardParams struct {
+	ExpiringWithinDays int
+	ReceivedMonths     int
+	TopUsers           int
+}
+
+// WithDefaults replaces unset values with the defaults.
+func (p DashboardParams) WithDefaults() DashboardParams {
+	if p.ExpiringWithinDays <= 0 {
+		p.ExpiringWithinDays = DefaultExpiringWithinDays
+	}
+	if p.ReceivedMonths <= 0 {
+		p.ReceivedMonths = DefaultReceivedMonths
+	}
+	if p.TopUsers <= 0 {
+		p.TopUsers = DefaultTopUsers
+	}
+	return p
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type KeyCount struct {
+	Key   string `json:"Key"`
+	Count int64  `json:"Count"`
+}
+
+type UserItemCount struct {
+	UserID   uint64 `json:"UserID"`
+	UserName string `json:"UserName"`
+	Count    int64  `json:"Count"`
+}
+
+type DashboardStats struct {
+	GeneratedAt        time.Time       `json:"GeneratedAt"`
+	TotalItems         int64           `json:"TotalItems"`
+	ByDeviceType       []KeyCount      `json:"ByDeviceType"`
+	ByAssetStatus      []KeyCount      `json:"ByAssetStatus"`
+	Assigned           int64           `json:"Assigned"`
+	Unassigned         int64           `json:"Unassigned"`
+	Expired            int64           `json:"Expired"`
+	Expiring           int64           `json:"Expiring"`
+	NoWarranty         int64           `json:"NoWarranty"`
+	ExpiringWithinDays int             `json:"ExpiringWithinDays"`
+	ReceivedPerMonth   []KeyCount      `json:"ReceivedPerMonth"`
+	TopUsers           []UserItemCount `json:"TopUsers"`
+	ByTag              []KeyCount      `json:"ByTag"`
+}
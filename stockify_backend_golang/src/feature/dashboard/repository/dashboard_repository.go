@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/dashboard/model"
+	"time"
+)
+
+type DashboardRepository interface {
+	GetStats(params model.DashboardParams, now time.Time) (model.DashboardStats, error)
+}
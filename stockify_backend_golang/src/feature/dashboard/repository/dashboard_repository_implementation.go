@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/common/timeutil"
+	"stockify_backend_golang/src/feature/dashboard/model"
+	tagrepository "stockify_backend_golang/src/feature/tag/repository"
+	"strconv"
+	"time"
+)
+
+type dashboardRepository struct{}
+
+func DashboardRepositoryImplementation() DashboardRepository {
+	return &dashboardRepository{}
+}
+
+// statsQuery computes every aggregate in one statement. Each branch of the
+// UNION emits (metric, key, label, count) rows that GetStats folds back into
+// the DashboardStats fields. A warranty date at or before the epoch is how
+// the app stores an item without a warranty.
+const statsQuery = `
+SELECT 'device_type' AS metric, device_type AS key, '' AS label, COUNT(*) AS count
+FROM items WHERE deleted_at IS NULL GROUP BY device_type
+UNION ALL
+SELECT 'asset_status', asset_status, '', COUNT(*)
+FROM items WHERE deleted_at IS NULL GROUP BY asset_status
+UNION ALL
+SELECT 'assignment', CASE WHEN assigned_to_id IS NULL THEN 'unassigned' ELSE 'assigned' END, '', COUNT(*)
+FROM items WHERE deleted_at IS NULL GROUP BY 2
+UNION ALL
+SELECT 'warranty',
+	CASE
+		WHEN DATE(warranty_date) <= '1970-01-01' THEN 'no_warranty'
+		WHEN DATE(warranty_date) < DATE(@today) THEN 'expired'
+		WHEN DATE(warranty_date) > DATE(@today) AND DATE(warranty_date) <= DATE(@expiringUntil) THEN 'expiring'
+		ELSE 'valid'
+	END, '', COUNT(*)
+FROM items WHERE deleted_at IS NULL GROUP BY 2
+UNION ALL
+SELECT 'received_month', strftime('%Y-%m', received_date), '', COUNT(*)
+FROM items WHERE deleted_at IS NULL AND received_date IS NOT NULL AND DATE(received_date) >= DATE(@receivedFrom)
+GROUP BY 2
+UNION ALL
+SELECT * FROM (
+	SELECT 'top_user', CAST(users.id AS TEXT), users.user_name, COUNT(*)
+	FROM items JOIN users ON users.id = items.assigned_to_id
+	WHERE items.deleted_at IS NULL AND users.deleted_at IS NULL
+	GROUP BY users.id, users.user_name
+	ORDER BY COUNT(*) DESC, users.user_name
+	LIMIT @topUsers
+)
+UNION ALL
+SELECT 'tag', name, '', count FROM (` + tagrepository.TagCountsQuery + `)`
+
+type statsRow struct {
+	Metric string
+	Key    string
+	Label  string
+	Count  int64
+}
+
+func (r *dashboardRepository) GetStats(params model.DashboardParams, now time.Time) (model.DashboardStats, error) {
+	params = params.WithDefaults()
+	today := timeutil.StartOfDay(now)
+	firstMonth := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()).
+		AddDate(0, -(params.ReceivedMonths - 1), 0)
+
+	var rows []statsRow
+	err := db.DB.Raw(statsQuery, map[string]interface{}{
+		"today":         today,
+		"expiringUntil": today.AddDate(0, 0, params.ExpiringWithinDays),
+		"receivedFrom":  firstMonth,
+		"topUsers":      params.TopUsers,
+	}).Scan(&rows).Error
+	if err != nil {
+		return model.DashboardStats{}, err
+	}
+
+	stats := model.DashboardStats{
+		GeneratedAt:        now,
+		ExpiringWithinDays: params.ExpiringWithinDays,
+		ByDeviceType:       []model.KeyCount{},
+		ByAssetStatus:      []model.KeyCount{},
+		TopUsers:           []model.UserItemCount{},
+		ByTag:              []model.KeyCount{},
+	}
+	receivedByMonth := map[string]int64{}
+	for _, row := range rows {
+		switch row.Metric {
+		case "device_type":
+			stats.ByDeviceType = append(stats.ByDeviceType, model.KeyCount{Key: row.Key, Count: row.Count})
+			stats.TotalItems += row.Count
+		case "asset_status":
+			stats.ByAssetStatus = append(stats.ByAssetStatus, model.KeyCount{Key: row.Key, Count: row.Count})
+		case "assignment":
+			if row.Key == "assigned" {
+				stats.Assigned = row.Count
+			} else {
+				stats.Unassigned = row.Count
+			}
+		case "warranty":
+			switch row.Key {
+			case "expired":
+				stats.Expired = row.Count
+			case "expiring":
+				stats.Expiring = row.Count
+			case "no_warranty":
+				stats.NoWarranty = row.Count
+			}
+		case "received_month":
+			receivedByMonth[row.Key] = row.Count
+		case "top_user":
+			id, _ := strconv.ParseUint(row.Key, 10, 64)
+			stats.TopUsers = append(stats.TopUsers, model.UserItemCount{UserID: id, UserName: row.Label, Count: row.Count})
+		case "tag":
+			stats.ByTag = append(stats.ByTag, model.KeyCount{Key: row.Key, Count: row.Count})
+		}
+	}
+
+	// Report every month in the window, including the ones with no items
+	for month := firstMonth; !month.After(today); month = month.AddDate(0, 1, 0) {
+		key := month.Format("2006-01")
+		stats.ReceivedPerMonth = append(stats.ReceivedPerMonth, model.KeyCount{Key: key, Count: receivedByMonth[key]})
+	}
+	return stats, nil
+}
@@ -0,0 +1,10 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/dashboard/model"
+	"time"
+)
+
+type DashboardService interface {
+	GetStats(params model.DashboardParams, now time.Time) (model.DashboardStats, error)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/dashboard/model"
+	"stockify_backend_golang/src/feature/dashboard/repository"
+	"time"
+)
+
+type dashboardService struct {
+	repo repository.DashboardRepository
+}
+
+func DashboardServiceImplementation(repo repository.DashboardRepository) DashboardService {
+	return &dashboardService{repo: repo}
+}
+
+func (s *dashboardService) GetStats(params model.DashboardParams, now time.Time) (model.DashboardStats, error) {
+	return s.repo.GetStats(params, now)
+}
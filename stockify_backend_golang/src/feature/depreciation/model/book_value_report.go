@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type ItemBookValue struct {
+	DepreciableItem
+	Method                  DepreciationMethod `json:"Method"`
+	UsefulLifeYears         int                `json:"UsefulLifeYears"`
+	AccumulatedDepreciation float64            `json:"AccumulatedDepreciation"`
+	BookValue               float64            `json:"BookValue"`
+	FullyDepreciated        bool               `json:"FullyDepreciated"`
+}
+
+// BookValueTotal adds up the items bought in one currency
+type BookValueTotal struct {
+	Currency                string  `json:"Currency"`
+	ItemCount               int     `json:"ItemCount"`
+	Cost                    float64 `json:"Cost"`
+	AccumulatedDepreciation float64 `json:"AccumulatedDepreciation"`
+	BookValue               float64 `json:"BookValue"`
+}
+
+// BookValueReport values the inventory at AsOf. Items missing a cost or a
+// received date cannot be valued and are listed in Incomplete instead.
+type BookValueReport struct {
+	AsOf       time.Time         `json:"AsOf"`
+	Items      []ItemBookValue   `json:"Items"`
+	Totals     []BookValueTotal  `json:"Totals"`
+	Incomplete []DepreciableItem `json:"Incomplete"`
+}
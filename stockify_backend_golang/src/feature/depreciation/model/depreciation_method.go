@@ -0,0 +1,10 @@
+package model
+
+type DepreciationMethod string
+
+const (
+	STRAIGHT_LINE     DepreciationMethod = "straight_line"
+	DECLINING_BALANCE DepreciationMethod = "declining_balance"
+)
+
+var DepreciationMethods = []DepreciationMethod{STRAIGHT_LINE, DECLINING_BALANCE}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// DepreciationPolicy decides how items of a device type lose value. The
+// policy without a DeviceTypeID applies to every type that has none of its own.
+// SalvagePercent is the share of the cost left at the end of the useful life;
+// DecliningFactor multiplies the straight-line rate for declining balance, 2
+// being double-declining.
+type DepreciationPolicy struct {
+	gorm.Model
+	ID              uint64             `gorm:"primaryKey;autoIncrement" json:"ID"`
+	DeviceTypeID    *uint64            `gorm:"uniqueIndex" json:"DeviceTypeID,omitempty"`
+	Method          DepreciationMethod `json:"Method"`
+	UsefulLifeYears int                `json:"UsefulLifeYears"`
+	SalvagePercent  float64            `json:"SalvagePercent"`
+	DecliningFactor float64            `json:"DecliningFactor"`
+}
+
+// DefaultPolicy is seeded as the global policy on first start.
+var DefaultPolicy = DepreciationPolicy{
+	Method:          STRAIGHT_LINE,
+	UsefulLifeYears: 4,
+	DecliningFactor: 2,
+}
+
+func (p *DepreciationPolicy) String() string {
+	deviceType := "All"
+	if p.DeviceTypeID != nil {
+		deviceType = fmt.Sprint(*p.DeviceTypeID)
+	}
+	return fmt.Sprintf("DepreciationPolicy{ID: %d, DeviceTypeID: %s, Method: %s, UsefulLifeYears: %d}",
+		p.ID, deviceType, p.Method, p.UsefulLifeYears)
+}
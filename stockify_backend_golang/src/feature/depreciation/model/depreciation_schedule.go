@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// ScheduleEntry is one year of an item's useful life, counted from the date
+// it was received.
+type ScheduleEntry struct {
+	Year                    int       `json:"Year"`
+	PeriodStart             time.Time `json:"PeriodStart"`
+	PeriodEnd               time.Time `json:"PeriodEnd"`
+	OpeningValue            float64   `json:"OpeningValue"`
+	Depreciation            float64   `json:"Depreciation"`
+	ClosingValue            float64   `json:"ClosingValue"`
+	AccumulatedDepreciation float64   `json:"AccumulatedDepreciation"`
+}
+
+// DepreciableItem is what depreciation needs to know about an item.
+type DepreciableItem struct {
+	ItemID       uint64     `json:"ItemID"`
+	AssetNo      string     `json:"AssetNo"`
+	DeviceType   string     `json:"DeviceType"`
+	DeviceTypeID *uint64    `json:"DeviceTypeID,omitempty"`
+	Cost         *float64   `json:"Cost,omitempty"`
+	Currency     string     `json:"Currency"`
+	ReceivedDate *time.Time `json:"ReceivedDate,omitempty"`
+}
+
+type ItemSchedule struct {
+	DepreciableItem
+	Policy  DepreciationPolicy `json:"Policy"`
+	Entries []ScheduleEntry    `json:"Entries"`
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/depreciation/model"
+)
+
+type DepreciationRepository interface {
+	GetAllPolicies() []model.DepreciationPolicy
+	GetPolicyById(id uint64) model.DepreciationPolicy
+	GetPolicyByDeviceTypeId(deviceTypeId *uint64) model.DepreciationPolicy
+	AddPolicy(policy model.DepreciationPolicy) error
+	UpdatePolicy(policy model.DepreciationPolicy) error
+	DeletePolicyById(id uint64)
+	GetDepreciableItems() ([]model.DepreciableItem, error)
+	GetDepreciableItemById(id uint64) (model.DepreciableItem, error)
+}
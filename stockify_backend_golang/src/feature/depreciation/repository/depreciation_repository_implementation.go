@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/depreciation/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.DepreciationPolicy{})
+	if err != nil {
+		log.Fatal("Failed to migrate DepreciationPolicy table: " + err.Error())
+	}
+	seedDefaultPolicy()
+}
+
+// seedDefaultPolicy adds the global policy once, so a fresh database values
+// items without any setup.
+func seedDefaultPolicy() {
+	var count int64
+	db.DB.Unscoped().Model(&model.DepreciationPolicy{}).Count(&count)
+	if count > 0 {
+		return
+	}
+	policy := model.DefaultPolicy
+	if err := db.DB.Create(&policy).Error; err != nil {
+		log.Println("Failed to seed depreciation policy:", err)
+	}
+}
+
+type depreciationRepository struct{}
+
+func DepreciationRepositoryImplementation() DepreciationRepository {
+	return &depreciationRepository{}
+}
+
+func (r *depreciationRepository) GetAllPolicies() []model.DepreciationPolicy {
+	var policies []model.DepreciationPolicy
+	db.DB.Order("device_type_id IS NOT NULL, device_type_id").Find(&policies)
+	return policies
+}
+
+func (r *depreciationRepository) GetPolicyById(id uint64) model.DepreciationPolicy {
+	var policy model.DepreciationPolicy
+	db.DB.First(&policy, id)
+	return policy
+}
+
+func (r *depreciationRepository) GetPolicyByDeviceTypeId(deviceTypeId *uint64) model.DepreciationPolicy {
+	var policy model.DepreciationPolicy
+	if deviceTypeId == nil {
+		db.DB.Where("device_type_id IS NULL").First(&policy)
+	} else {
+		db.DB.Where("device_type_id = ?", *deviceTypeId).First(&policy)
+	}
+	return policy
+}
+
+func (r *depreciationRepository) AddPolicy(policy model.DepreciationPolicy) error {
+	return db.DB.Create(&policy).Error
+}
+
+func (r *depreciationRepository) UpdatePolicy(policy model.DepreciationPolicy) error {
+	return db.DB.Save(&policy).Error
+}
+
+// DeletePolicyById hard-deletes the policy, as a device type has at most one
+func (r *depreciationRepository) DeletePolicyById(id uint64) {
+	db.HardDelete(&model.DepreciationPolicy{}, id)
+}
+
+// GetDepreciableItems returns every item still on the books, i.e. not
+// disposed. The currency falls back to the one of the purchase order.
+func (r *depreciationRepository) GetDepreciableItems() ([]model.DepreciableItem, error) {
+	var items []model.DepreciableItem
+	err := depreciableItems().Order("items.asset_no").Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *depreciationRepository) GetDepreciableItemById(id uint64) (model.DepreciableItem, error) {
+	var items []model.DepreciableItem
+	err := depreciableItems().Where("items.id = ?", id).Scan(&items).Error
+	if err != nil || len(items) == 0 {
+		return model.DepreciableItem{}, err
+	}
+	return items[0], nil
+}
+
+func depreciableItems() *gorm.DB {
+	return db.DB.Table("items").
+		Select("items.id AS item_id, items.asset_no, items.device_type, items.device_type_id, "+
+			"items.purchase_price AS cost, COALESCE(items.currency, purchase_orders.currency, '') AS currency, "+
+			"items.received_date").
+		Joins("LEFT JOIN purchase_orders ON purchase_orders.id = items.purchase_order_id").
+		Where("items.deleted_at IS NULL AND items.asset_status <> ?", itemmodel.DISPOSED)
+}
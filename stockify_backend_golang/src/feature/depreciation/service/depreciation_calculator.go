@@ -0,0 +1,82 @@
+package service
+
+import (
+	"math"
+	"stockify_backend_golang/src/feature/depreciation/model"
+	"time"
+)
+
+// CalculateSchedule lays out the yearly depreciation of an item that cost
+// cost and was received on start. Amounts are rounded to cents per year and
+// the last year takes whatever is left down to the salvage value, so the
+// schedule always ends exactly there.
+//
+// Straight-line spreads cost minus salvage evenly over the useful life.
+// Declining balance takes DecliningFactor / UsefulLifeYears of the opening
+// value every year without going below salvage, e.g. 10000 over 5 years at
+// factor 2 gives 4000, 2400, 1440, 864 and 1296.
+func CalculateSchedule(cost float64, start time.Time, policy model.DepreciationPolicy) []model.ScheduleEntry {
+	life := policy.UsefulLifeYears
+	if life < 1 {
+		life = 1
+	}
+	salvage := roundCents(cost * policy.SalvagePercent / 100)
+	factor := policy.DecliningFactor
+	if factor <= 0 {
+		factor = model.DefaultPolicy.DecliningFactor
+	}
+
+	entries := make([]model.ScheduleEntry, 0, life)
+	opening := roundCents(cost)
+	accumulated := 0.0
+	for year := 1; year <= life; year++ {
+		var depreciation float64
+		switch {
+		case year == life:
+			depreciation = opening - salvage
+		case policy.Method == model.DECLINING_BALANCE:
+			depreciation = roundCents(opening * factor / float64(life))
+		default:
+			depreciation = roundCents((cost - salvage) / float64(life))
+		}
+		depreciation = math.Max(0, math.Min(depreciation, opening-salvage))
+		depreciation = roundCents(depreciation)
+		accumulated = roundCents(accumulated + depreciation)
+		entries = append(entries, model.ScheduleEntry{
+			Year:                    year,
+			PeriodStart:             start.AddDate(year-1, 0, 0),
+			PeriodEnd:               start.AddDate(year, 0, 0),
+			OpeningValue:            opening,
+			Depreciation:            depreciation,
+			ClosingValue:            roundCents(opening - depreciation),
+			AccumulatedDepreciation: accumulated,
+		})
+		opening = roundCents(opening - depreciation)
+	}
+	return entries
+}
+
+// BookValueAt reads the value of an item on asOf from its schedule. Within a
+// year the depreciation accrues evenly by day.
+func BookValueAt(cost float64, schedule []model.ScheduleEntry, asOf time.Time) (accumulated, bookValue float64) {
+	cost = roundCents(cost)
+	if len(schedule) == 0 || asOf.Before(schedule[0].PeriodStart) {
+		return 0, cost
+	}
+	for _, entry := range schedule {
+		if !asOf.Before(entry.PeriodEnd) {
+			continue
+		}
+		elapsed := asOf.Sub(entry.PeriodStart).Hours()
+		length := entry.PeriodEnd.Sub(entry.PeriodStart).Hours()
+		accrued := roundCents(entry.Depreciation * elapsed / length)
+		accumulated = roundCents(entry.AccumulatedDepreciation - entry.Depreciation + accrued)
+		return accumulated, roundCents(cost - accumulated)
+	}
+	last := schedule[len(schedule)-1]
+	return last.AccumulatedDepreciation, last.ClosingValue
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
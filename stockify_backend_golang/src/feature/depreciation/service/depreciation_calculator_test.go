@@ -0,0 +1,143 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/depreciation/model"
+	"testing"
+	"time"
+)
+
+var scheduleStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestCalculateSchedule(t *testing.T) {
+	tests := []struct {
+		name         string
+		cost         float64
+		policy       model.DepreciationPolicy
+		depreciation []float64
+		closing      float64
+	}{
+		{
+			name:         "straight-line",
+			cost:         10000,
+			policy:       model.DepreciationPolicy{Method: model.STRAIGHT_LINE, UsefulLifeYears: 5},
+			depreciation: []float64{2000, 2000, 2000, 2000, 2000},
+			closing:      0,
+		},
+		{
+			name:         "straight-line with salvage",
+			cost:         10000,
+			policy:       model.DepreciationPolicy{Method: model.STRAIGHT_LINE, UsefulLifeYears: 5, SalvagePercent: 10},
+			depreciation: []float64{1800, 1800, 1800, 1800, 1800},
+			closing:      1000,
+		},
+		{
+			name:         "straight-line last year takes the rounding",
+			cost:         1000,
+			policy:       model.DepreciationPolicy{Method: model.STRAIGHT_LINE, UsefulLifeYears: 3},
+			depreciation: []float64{333.33, 333.33, 333.34},
+			closing:      0,
+		},
+		{
+			name:         "declining balance",
+			cost:         10000,
+			policy:       model.DepreciationPolicy{Method: model.DECLINING_BALANCE, UsefulLifeYears: 5, DecliningFactor: 2},
+			depreciation: []float64{4000, 2400, 1440, 864, 1296},
+			closing:      0,
+		},
+		{
+			name:         "declining balance with salvage",
+			cost:         10000,
+			policy:       model.DepreciationPolicy{Method: model.DECLINING_BALANCE, UsefulLifeYears: 5, DecliningFactor: 2, SalvagePercent: 10},
+			depreciation: []float64{4000, 2400, 1440, 864, 296},
+			closing:      1000,
+		},
+		{
+			name:         "declining balance stops at salvage",
+			cost:         10000,
+			policy:       model.DepreciationPolicy{Method: model.DECLINING_BALANCE, UsefulLifeYears: 4, DecliningFactor: 2, SalvagePercent: 30},
+			depreciation: []float64{5000, 2000, 0, 0},
+			closing:      3000,
+		},
+		{
+			name:         "declining balance without factor uses the default",
+			cost:         8000,
+			policy:       model.DepreciationPolicy{Method: model.DECLINING_BALANCE, UsefulLifeYears: 4},
+			depreciation: []float64{4000, 2000, 1000, 1000},
+			closing:      0,
+		},
+		{
+			name:         "life below one year counts as one",
+			cost:         500,
+			policy:       model.DepreciationPolicy{Method: model.STRAIGHT_LINE},
+			depreciation: []float64{500},
+			closing:      0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := CalculateSchedule(test.cost, scheduleStart, test.policy)
+			if len(schedule) != len(test.depreciation) {
+				t.Fatalf("got %d years, want %d", len(schedule), len(test.depreciation))
+			}
+			opening := test.cost
+			accumulated := 0.0
+			for i, entry := range schedule {
+				accumulated = roundCents(accumulated + test.depreciation[i])
+				if entry.Year != i+1 {
+					t.Errorf("year %d: Year = %d", i+1, entry.Year)
+				}
+				if entry.OpeningValue != opening {
+					t.Errorf("year %d: OpeningValue = %v, want %v", i+1, entry.OpeningValue, opening)
+				}
+				if entry.Depreciation != test.depreciation[i] {
+					t.Errorf("year %d: Depreciation = %v, want %v", i+1, entry.Depreciation, test.depreciation[i])
+				}
+				if entry.AccumulatedDepreciation != accumulated {
+					t.Errorf("year %d: AccumulatedDepreciation = %v, want %v", i+1, entry.AccumulatedDepreciation, accumulated)
+				}
+				if !entry.PeriodStart.Equal(scheduleStart.AddDate(i, 0, 0)) || !entry.PeriodEnd.Equal(scheduleStart.AddDate(i+1, 0, 0)) {
+					t.Errorf("year %d: period %s - %s", i+1, entry.PeriodStart, entry.PeriodEnd)
+				}
+				opening = entry.ClosingValue
+			}
+			if last := schedule[len(schedule)-1]; last.ClosingValue != test.closing {
+				t.Errorf("ClosingValue = %v, want %v", last.ClosingValue, test.closing)
+			}
+		})
+	}
+}
+
+func TestBookValueAt(t *testing.T) {
+	straightLine := CalculateSchedule(10000, scheduleStart,
+		model.DepreciationPolicy{Method: model.STRAIGHT_LINE, UsefulLifeYears: 5, SalvagePercent: 10})
+	declining := CalculateSchedule(10000, scheduleStart,
+		model.DepreciationPolicy{Method: model.DECLINING_BALANCE, UsefulLifeYears: 5, DecliningFactor: 2})
+	// 2025 has 365 days, so noon on 2 July is halfway through the second year
+	midSecondYear := time.Date(2025, time.July, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		schedule    []model.ScheduleEntry
+		asOf        time.Time
+		accumulated float64
+		bookValue   float64
+	}{
+		{"before the schedule", straightLine, scheduleStart.AddDate(0, 0, -1), 0, 10000},
+		{"first day", straightLine, scheduleStart, 0, 10000},
+		{"quarter into the first year", straightLine, scheduleStart.Add(366 * 24 * time.Hour / 4), 450, 9550},
+		{"start of the second year", straightLine, scheduleStart.AddDate(1, 0, 0), 1800, 8200},
+		{"straight-line halfway through a year", straightLine, midSecondYear, 2700, 7300},
+		{"declining balance halfway through a year", declining, midSecondYear, 5200, 4800},
+		{"end of the life", straightLine, scheduleStart.AddDate(5, 0, 0), 9000, 1000},
+		{"long after the life", straightLine, scheduleStart.AddDate(20, 0, 0), 9000, 1000},
+		{"no schedule", nil, midSecondYear, 0, 10000},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accumulated, bookValue := BookValueAt(10000, test.schedule, test.asOf)
+			if accumulated != test.accumulated || bookValue != test.bookValue {
+				t.Errorf("got %v / %v, want %v / %v", accumulated, bookValue, test.accumulated, test.bookValue)
+			}
+		})
+	}
+}
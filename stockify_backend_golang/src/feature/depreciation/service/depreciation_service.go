@@ -0,0 +1,15 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/depreciation/model"
+	"time"
+)
+
+type DepreciationService interface {
+	GetAllPolicies() []model.DepreciationPolicy
+	AddPolicy(policy model.DepreciationPolicy) error
+	UpdatePolicy(policy model.DepreciationPolicy) error
+	DeletePolicyById(id uint64) error
+	GetItemSchedule(itemId uint64) (model.ItemSchedule, error)
+	GetBookValueReport(asOf time.Time) (model.BookValueReport, error)
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"stockify_backend_golang/src/feature/depreciation/model"
+	"stockify_backend_golang/src/feature/depreciation/repository"
+	"time"
+)
+
+type depreciationService struct {
+	repo repository.DepreciationRepository
+}
+
+func DepreciationServiceImplementation(repo repository.DepreciationRepository) DepreciationService {
+	return &depreciationService{repo: repo}
+}
+
+func (s *depreciationService) GetAllPolicies() []model.DepreciationPolicy {
+	return s.repo.GetAllPolicies()
+}
+
+func (s *depreciationService) AddPolicy(policy model.DepreciationPolicy) error {
+	if err := validatePolicy(&policy); err != nil {
+		return err
+	}
+	if s.repo.GetPolicyByDeviceTypeId(policy.DeviceTypeID).ID != 0 {
+		return errors.New("a depreciation policy for this device type already exists")
+	}
+	return s.repo.AddPolicy(policy)
+}
+
+func (s *depreciationService) UpdatePolicy(policy model.DepreciationPolicy) error {
+	existing := s.repo.GetPolicyById(policy.ID)
+	if existing.ID == 0 {
+		return errors.New("depreciation policy not found")
+	}
+	if err := validatePolicy(&policy); err != nil {
+		return err
+	}
+	if other := s.repo.GetPolicyByDeviceTypeId(policy.DeviceTypeID); other.ID != 0 && other.ID != policy.ID {
+		return errors.New("a depreciation policy for this device type already exists")
+	}
+	policy.CreatedAt = existing.CreatedAt
+	return s.repo.UpdatePolicy(policy)
+}
+
+func (s *depreciationService) DeletePolicyById(id uint64) error {
+	policy := s.repo.GetPolicyById(id)
+	if policy.ID == 0 {
+		return errors.New("depreciation policy not found")
+	}
+	if policy.DeviceTypeID == nil {
+		return errors.New("the default depreciation policy cannot be deleted")
+	}
+	s.repo.DeletePolicyById(id)
+	return nil
+}
+
+func (s *depreciationService) GetItemSchedule(itemId uint64) (model.ItemSchedule, error) {
+	item, err := s.repo.GetDepreciableItemById(itemId)
+	if err != nil {
+		return model.ItemSchedule{}, err
+	}
+	if item.ItemID == 0 {
+		return model.ItemSchedule{}, errors.New("item not found or disposed")
+	}
+	if item.Cost == nil || item.ReceivedDate == nil {
+		return model.ItemSchedule{}, errors.New("item needs a purchase price and a received date")
+	}
+	policy := s.policyFor(item, s.policiesByDeviceType())
+	return model.ItemSchedule{
+		DepreciableItem: item,
+		Policy:          policy,
+		Entries:         CalculateSchedule(*item.Cost, *item.ReceivedDate, policy),
+	}, nil
+}
+
+// GetBookValueReport values every item on the books at asOf, with totals per
+// currency.
+func (s *depreciationService) GetBookValueReport(asOf time.Time) (model.BookValueReport, error) {
+	items, err := s.repo.GetDepreciableItems()
+	if err != nil {
+		return model.BookValueReport{}, err
+	}
+	policies := s.policiesByDeviceType()
+	report := model.BookValueReport{
+		AsOf:       asOf,
+		Items:      []model.ItemBookValue{},
+		Totals:     []model.BookValueTotal{},
+		Incomplete: []model.DepreciableItem{},
+	}
+	totals := make(map[string]*model.BookValueTotal)
+	for _, item := range items {
+		if item.Cost == nil || item.ReceivedDate == nil {
+			report.Incomplete = append(report.Incomplete, item)
+			continue
+		}
+		policy := s.policyFor(item, policies)
+		schedule := CalculateSchedule(*item.Cost, *item.ReceivedDate, policy)
+		accumulated, bookValue := BookValueAt(*item.Cost, schedule, asOf)
+		report.Items = append(report.Items, model.ItemBookValue{
+			DepreciableItem:         item,
+			Method:                  policy.Method,
+			UsefulLifeYears:         policy.UsefulLifeYears,
+			AccumulatedDepreciation: accumulated,
+			BookValue:               bookValue,
+			FullyDepreciated:        !asOf.Before(schedule[len(schedule)-1].PeriodEnd),
+		})
+		total := totals[item.Currency]
+		if total == nil {
+			total = &model.BookValueTotal{Currency: item.Currency}
+			totals[item.Currency] = total
+		}
+		total.ItemCount++
+		total.Cost = roundCents(total.Cost + *item.Cost)
+		total.AccumulatedDepreciation = roundCents(total.AccumulatedDepreciation + accumulated)
+		total.BookValue = roundCents(total.BookValue + bookValue)
+	}
+	for _, total := range totals {
+		report.Totals = append(report.Totals, *total)
+	}
+	slices.SortFunc(report.Totals, func(a, b model.BookValueTotal) int {
+		if a.Currency < b.Currency {
+			return -1
+		}
+		if a.Currency > b.Currency {
+			return 1
+		}
+		return 0
+	})
+	return report, nil
+}
+
+// policiesByDeviceType keys the policies by device type id, 0 for the default
+func (s *depreciationService) policiesByDeviceType() map[uint64]model.DepreciationPolicy {
+	policies := make(map[uint64]model.DepreciationPolicy)
+	for _, policy := range s.repo.GetAllPolicies() {
+		var key uint64
+		if policy.DeviceTypeID != nil {
+			key = *policy.DeviceTypeID
+		}
+		policies[key] = policy
+	}
+	return policies
+}
+
+func (s *depreciationService) policyFor(item model.DepreciableItem, policies map[uint64]model.DepreciationPolicy) model.DepreciationPolicy {
+	if item.DeviceTypeID != nil {
+		if policy, ok := policies[*item.DeviceTypeID]; ok {
+			return policy
+		}
+	}
+	if policy, ok := policies[0]; ok {
+		return policy
+	}
+	return model.DefaultPolicy
+}
+
+func validatePolicy(policy *model.DepreciationPolicy) error {
+	if !slices.Contains(model.DepreciationMethods, policy.Method) {
+		return fmt.Errorf("unknown depreciation method %q", policy.Method)
+	}
+	if policy.UsefulLifeYears < 1 || policy.UsefulLifeYears > 50 {
+		return errors.New("useful life must be between 1 and 50 years")
+	}
+	if policy.SalvagePercent < 0 || policy.SalvagePercent >= 100 {
+		return errors.New("salvage value must be at least 0% and less than 100% of the cost")
+	}
+	if policy.DecliningFactor < 0 {
+		return errors.New("declining factor cannot be negative")
+	}
+	if policy.DecliningFactor == 0 {
+		policy.DecliningFactor = model.DefaultPolicy.DecliningFactor
+	}
+	return nil
+}
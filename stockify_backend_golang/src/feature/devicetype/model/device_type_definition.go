@@ -0,0 +1,30 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// DeviceTypeDefinition is an entry of the device type catalog. Items store the
+// Code in their DeviceType column and reference the entry by DeviceTypeID.
+// Only entries that are not deleted need a unique code; deleted built-in
+// entries are kept so they are not seeded again. BuiltInCode is the code a
+// built-in entry was seeded with; it stays when Code is renamed so the
+// built-in types can still be told apart.
+type DeviceTypeDefinition struct {
+	gorm.Model
+	ID          uint64            `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Code        string            `gorm:"uniqueIndex:idx_device_type_definitions_live_code,where:deleted_at IS NULL" json:"Code"`
+	DisplayName string            `json:"DisplayName"`
+	IconKey     string            `json:"IconKey"`
+	SortOrder   int               `json:"SortOrder"`
+	BuiltIn     bool              `json:"BuiltIn"`
+	BuiltInCode string            `gorm:"index" json:"BuiltInCode,omitempty"`
+	Metadata    map[string]string `gorm:"serializer:json" json:"Metadata,omitempty"`
+}
+
+func (d *DeviceTypeDefinition) String() string {
+	return fmt.Sprintf("DeviceTypeDefinition{ID: %d, Code: %s, DisplayName: %s, IconKey: %s}",
+		d.ID, d.Code, d.DisplayName, d.IconKey)
+}
@@ -0,0 +1,7 @@
+package model
+
+// DeviceTypeReference counts the records of one kind that use a device type
+type DeviceTypeReference struct {
+	Kind  string `json:"Kind"`
+	Count int64  `json:"Count"`
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/devicetype/model"
+)
+
+type DeviceTypeRepository interface {
+	GetAllDeviceTypes() []model.DeviceTypeDefinition
+	GetDeviceTypeById(id uint64) model.DeviceTypeDefinition
+	GetDeviceTypeByCode(code string) model.DeviceTypeDefinition
+	GetBuiltInDeviceType(code string) model.DeviceTypeDefinition
+	AddDeviceType(deviceType *model.DeviceTypeDefinition) error
+	UpdateDeviceType(deviceType model.DeviceTypeDefinition, previousCode string) error
+	DeleteDeviceTypeById(id uint64)
+	CountReferences(deviceType model.DeviceTypeDefinition) []model.DeviceTypeReference
+}
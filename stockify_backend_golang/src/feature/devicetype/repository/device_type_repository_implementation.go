@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/devicetype/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	// The code used to be unique among deleted entries as well
+	if db.DB.Migrator().HasIndex(&model.DeviceTypeDefinition{}, "idx_device_type_definitions_code") {
+		if err := db.DB.Migrator().DropIndex(&model.DeviceTypeDefinition{}, "idx_device_type_definitions_code"); err != nil {
+			log.Fatal("Failed to migrate DeviceTypeDefinition table: " + err.Error())
+		}
+	}
+	err := db.DB.AutoMigrate(&model.DeviceTypeDefinition{})
+	if err != nil {
+		log.Fatal("Failed to migrate DeviceTypeDefinition table: " + err.Error())
+	}
+	seedBuiltInDeviceTypes()
+}
+
+// seedBuiltInDeviceTypes adds the device types Stockify has always shipped
+// with to the catalog, skipping any that already exist, were renamed or were
+// deleted.
+func seedBuiltInDeviceTypes() {
+	// Entries seeded before BuiltInCode existed
+	err := db.DB.Exec("UPDATE device_type_definitions SET built_in_code = code WHERE built_in AND COALESCE(built_in_code, '') = ''").Error
+	if err != nil {
+		log.Fatal("Failed to migrate DeviceTypeDefinition table: " + err.Error())
+	}
+	for i, deviceType := range itemmodel.DeviceTypes {
+		code := string(deviceType)
+		var count int64
+		db.DB.Unscoped().Model(&model.DeviceTypeDefinition{}).Where("code = ? OR built_in_code = ?", code, code).Count(&count)
+		if count > 0 {
+			continue
+		}
+		db.DB.Create(&model.DeviceTypeDefinition{
+			Code:        code,
+			DisplayName: code,
+			IconKey:     strings.ToLower(code),
+			SortOrder:   i,
+			BuiltIn:     true,
+			BuiltInCode: code,
+		})
+	}
+}
+
+// enumPrefix is what the app used to put in front of device type codes, as it
+// sent the Dart enum's toString() rather than its name
+const enumPrefix = "DeviceType."
+
+// references are the tables pointing into the catalog by device_type_id, with
+// what their rows are called in messages
+var references = []struct{ table, kind string }{
+	{"items", "item(s)"},
+	{"consumables", "consumable(s)"},
+	{"attribute_definitions", "custom attribute(s)"},
+	{"depreciation_policies", "depreciation policy"},
+	{"maintenance_schedules", "maintenance schedule(s)"},
+}
+
+// LinkItemsToCatalog migrates items from the old free-form device type strings
+// to catalog references. Strings without a catalog entry get one so that no
+// item is left unlinked. It is called once the items table is migrated.
+func LinkItemsToCatalog() {
+	if err := mergePrefixedCodes(); err != nil {
+		log.Fatal("Failed to fix device types stored by the app: " + err.Error())
+	}
+	now := time.Now()
+	err := db.DB.Exec(`INSERT INTO device_type_definitions (code, display_name, icon_key, sort_order, built_in, created_at, updated_at)
+		SELECT DISTINCT device_type, device_type, LOWER(device_type), ?, false, ?, ?
+		FROM items
+		WHERE device_type <> '' AND device_type NOT IN (SELECT code FROM device_type_definitions)`,
+		len(itemmodel.DeviceTypes), now, now).Error
+	if err != nil {
+		log.Fatal("Failed to add unknown device types to the catalog: " + err.Error())
+	}
+	err = db.DB.Exec(`UPDATE items SET device_type_id =
+		(SELECT id FROM device_type_definitions WHERE device_type_definitions.code = items.device_type)
+		WHERE device_type_id IS NULL AND device_type <> ''`).Error
+	if err != nil {
+		log.Fatal("Failed to link items to the device type catalog: " + err.Error())
+	}
+}
+
+// mergePrefixedCodes strips enumPrefix from the codes stored on items and in
+// the catalog. A catalog entry that only differs by the prefix from another is
+// merged into it: whatever referenced it is moved over, unless the other entry
+// already has its own, e.g. a depreciation policy.
+func mergePrefixedCodes() error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("UPDATE items SET device_type = SUBSTR(device_type, ?) WHERE device_type LIKE ?",
+			len(enumPrefix)+1, enumPrefix+"%").Error
+		if err != nil {
+			return err
+		}
+		var prefixed []model.DeviceTypeDefinition
+		if err := tx.Unscoped().Where("code LIKE ?", enumPrefix+"%").Find(&prefixed).Error; err != nil {
+			return err
+		}
+		for _, duplicate := range prefixed {
+			code := strings.TrimPrefix(duplicate.Code, enumPrefix)
+			var target model.DeviceTypeDefinition
+			if err := tx.Where("code = ?", code).Limit(1).Find(&target).Error; err != nil {
+				return err
+			}
+			if target.ID == 0 || target.ID == duplicate.ID {
+				err := tx.Unscoped().Model(&duplicate).Updates(map[string]interface{}{
+					"code":         code,
+					"display_name": strings.TrimPrefix(duplicate.DisplayName, enumPrefix),
+					"icon_key":     strings.ToLower(code),
+				}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			for _, reference := range references {
+				if !tx.Migrator().HasTable(reference.table) {
+					continue
+				}
+				err := tx.Exec("UPDATE OR IGNORE "+reference.table+" SET device_type_id = ? WHERE device_type_id = ?",
+					target.ID, duplicate.ID).Error
+				if err == nil {
+					err = tx.Exec("DELETE FROM "+reference.table+" WHERE device_type_id = ?", duplicate.ID).Error
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if err := tx.Unscoped().Delete(&duplicate).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type deviceTypeRepository struct{}
+
+func DeviceTypeRepositoryImplementation() DeviceTypeRepository {
+	return &deviceTypeRepository{}
+}
+
+func (r *deviceTypeRepository) GetAllDeviceTypes() []model.DeviceTypeDefinition {
+	var deviceTypes []model.DeviceTypeDefinition
+	db.DB.Order("sort_order, display_name").Find(&deviceTypes)
+	return deviceTypes
+}
+
+func (r *deviceTypeRepository) GetDeviceTypeById(id uint64) model.DeviceTypeDefinition {
+	var deviceType model.DeviceTypeDefinition
+	db.DB.First(&deviceType, id)
+	return deviceType
+}
+
+func (r *deviceTypeRepository) GetDeviceTypeByCode(code string) model.DeviceTypeDefinition {
+	var deviceType model.DeviceTypeDefinition
+	db.DB.Where("code = ?", code).Limit(1).Find(&deviceType)
+	return deviceType
+}
+
+// GetBuiltInDeviceType finds the built-in entry seeded with the code, whatever
+// it has been renamed to since
+func (r *deviceTypeRepository) GetBuiltInDeviceType(code string) model.DeviceTypeDefinition {
+	var deviceType model.DeviceTypeDefinition
+	db.DB.Where("built_in_code = ?", code).Limit(1).Find(&deviceType)
+	return deviceType
+}
+
+func (r *deviceTypeRepository) AddDeviceType(deviceType *model.DeviceTypeDefinition) error {
+	// Bring back a deleted entry with the same code rather than adding a second one
+	var deleted model.DeviceTypeDefinition
+	db.DB.Unscoped().Where("code = ? AND deleted_at IS NOT NULL", deviceType.Code).Limit(1).Find(&deleted)
+	if deleted.ID != 0 {
+		deviceType.ID = deleted.ID
+		deviceType.CreatedAt = deleted.CreatedAt
+		deviceType.BuiltIn = deleted.BuiltIn
+		deviceType.BuiltInCode = deleted.BuiltInCode
+		return db.DB.Unscoped().Save(deviceType).Error
+	}
+	return db.DB.Create(deviceType).Error
+}
+
+// UpdateDeviceType saves the entry and, when its code changed, rewrites the
+// code wherever it is stored instead of the id: on items, in notification
+// rules and in the filters of saved searches.
+func (r *deviceTypeRepository) UpdateDeviceType(deviceType model.DeviceTypeDefinition, previousCode string) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&deviceType).Error; err != nil {
+			return err
+		}
+		if previousCode == deviceType.Code {
+			return nil
+		}
+		if err := tx.Exec("UPDATE items SET device_type = ? WHERE device_type_id = ?", deviceType.Code, deviceType.ID).Error; err != nil {
+			return err
+		}
+		if tx.Migrator().HasTable("notification_rules") {
+			err := tx.Exec("UPDATE notification_rules SET device_type = ? WHERE device_type = ?", deviceType.Code, previousCode).Error
+			if err != nil {
+				return err
+			}
+		}
+		if tx.Migrator().HasTable("saved_searches") {
+			err := tx.Exec(`UPDATE saved_searches SET filter = json_set(filter, '$.DeviceType', ?)
+				WHERE json_extract(filter, '$.DeviceType') = ?`, deviceType.Code, previousCode).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *deviceTypeRepository) DeleteDeviceTypeById(id uint64) {
+	db.DB.Delete(&model.DeviceTypeDefinition{}, id)
+}
+
+// CountReferences counts what still uses the device type, by its id or, for
+// notification rules and saved searches, by its code. Kinds nothing of which
+// uses it are left out.
+func (r *deviceTypeRepository) CountReferences(deviceType model.DeviceTypeDefinition) []model.DeviceTypeReference {
+	var counts []model.DeviceTypeReference
+	count := func(kind, table, where string, value interface{}) {
+		if !db.DB.Migrator().HasTable(table) {
+			return
+		}
+		var count int64
+		db.DB.Table(table).Where(where+" AND deleted_at IS NULL", value).Count(&count)
+		if count > 0 {
+			counts = append(counts, model.DeviceTypeReference{Kind: kind, Count: count})
+		}
+	}
+	for _, reference := range references {
+		count(reference.kind, reference.table, "device_type_id = ?", deviceType.ID)
+	}
+	count("notification rule(s)", "notification_rules", "device_type = ?", deviceType.Code)
+	count("saved search(es)", "saved_searches", "json_extract(filter, '$.DeviceType') = ?", deviceType.Code)
+	return counts
+}
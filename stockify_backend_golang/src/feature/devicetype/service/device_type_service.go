@@ -0,0 +1,11 @@
+package service
+
+import "stockify_backend_golang/src/feature/devicetype/model"
+
+type DeviceTypeService interface {
+	GetAllDeviceTypes() []model.DeviceTypeDefinition
+	GetDeviceTypeById(id uint64) model.DeviceTypeDefinition
+	AddDeviceType(deviceType model.DeviceTypeDefinition) error
+	UpdateDeviceType(deviceType model.DeviceTypeDefinition) error
+	DeleteDeviceTypeById(id uint64) error
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"stockify_backend_golang/src/feature/devicetype/model"
+	"stockify_backend_golang/src/feature/devicetype/repository"
+	"strings"
+)
+
+type deviceTypeService struct {
+	repo repository.DeviceTypeRepository
+}
+
+func DeviceTypeServiceImplementation(repo repository.DeviceTypeRepository) DeviceTypeService {
+	return &deviceTypeService{repo: repo}
+}
+
+func (s *deviceTypeService) GetAllDeviceTypes() []model.DeviceTypeDefinition {
+	return s.repo.GetAllDeviceTypes()
+}
+
+func (s *deviceTypeService) GetDeviceTypeById(id uint64) model.DeviceTypeDefinition {
+	return s.repo.GetDeviceTypeById(id)
+}
+
+func (s *deviceTypeService) AddDeviceType(deviceType model.DeviceTypeDefinition) error {
+	normalize(&deviceType)
+	if deviceType.Code == "" {
+		return errors.New("device type code is required")
+	}
+	if s.repo.GetDeviceTypeByCode(deviceType.Code).ID != 0 {
+		return fmt.Errorf("device type %q already exists", deviceType.Code)
+	}
+	deviceType.BuiltIn = false
+	deviceType.BuiltInCode = ""
+	return s.repo.AddDeviceType(&deviceType)
+}
+
+func (s *deviceTypeService) UpdateDeviceType(deviceType model.DeviceTypeDefinition) error {
+	normalize(&deviceType)
+	if deviceType.Code == "" {
+		return errors.New("device type code is required")
+	}
+	existing := s.repo.GetDeviceTypeById(deviceType.ID)
+	if existing.ID == 0 {
+		return errors.New("device type not found")
+	}
+	if other := s.repo.GetDeviceTypeByCode(deviceType.Code); other.ID != 0 && other.ID != deviceType.ID {
+		return fmt.Errorf("device type %q already exists", deviceType.Code)
+	}
+	deviceType.BuiltIn = existing.BuiltIn
+	deviceType.BuiltInCode = existing.BuiltInCode
+	deviceType.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateDeviceType(deviceType, existing.Code)
+}
+
+// DeleteDeviceTypeById refuses to delete a device type anything still uses,
+// be it items, consumables, custom attributes, policies, schedules,
+// notification rules or saved searches.
+func (s *deviceTypeService) DeleteDeviceTypeById(id uint64) error {
+	deviceType := s.repo.GetDeviceTypeById(id)
+	if deviceType.ID == 0 {
+		return errors.New("device type not found")
+	}
+	if references := s.repo.CountReferences(deviceType); len(references) > 0 {
+		uses := make([]string, len(references))
+		for i, reference := range references {
+			uses[i] = fmt.Sprintf("%d %s", reference.Count, reference.Kind)
+		}
+		return fmt.Errorf("device type is used by %s", strings.Join(uses, ", "))
+	}
+	s.repo.DeleteDeviceTypeById(id)
+	return nil
+}
+
+func normalize(deviceType *model.DeviceTypeDefinition) {
+	deviceType.Code = strings.TrimSpace(deviceType.Code)
+	deviceType.DisplayName = strings.TrimSpace(deviceType.DisplayName)
+	if deviceType.DisplayName == "" {
+		deviceType.DisplayName = deviceType.Code
+	}
+	if deviceType.IconKey == "" {
+		deviceType.IconKey = strings.ToLower(deviceType.Code)
+	}
+}
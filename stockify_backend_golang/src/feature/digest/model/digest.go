@@ -0,0 +1,29 @@
+package model
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+)
+
+// DigestSection is one block of the email, listing the items matched by Filter.
+type DigestSection struct {
+	Title  string                     `json:"Title"`
+	Filter itemmodel.ItemFilterParams `json:"-"`
+	Items  []itemmodel.Item           `json:"Items"`
+}
+
+// Digest is the data handed to the subject and body templates.
+type Digest struct {
+	GeneratedAt        time.Time       `json:"GeneratedAt"`
+	ExpiringWithinDays int             `json:"ExpiringWithinDays"`
+	Sections           []DigestSection `json:"Sections"`
+}
+
+func (d Digest) IsEmpty() bool {
+	for _, section := range d.Sections {
+		if len(section.Items) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"stockify_backend_golang/src/common/mail"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DigestConfig is a single-row table holding the SMTP settings, recipients,
+// templates and schedule of the email digest.
+type DigestConfig struct {
+	gorm.Model
+	ID                 uint64       `gorm:"primaryKey;autoIncrement" json:"ID"`
+	SMTPHost           string       `json:"SMTPHost"`
+	SMTPPort           int          `json:"SMTPPort"`
+	SMTPUsername       string       `json:"SMTPUsername"`
+	SMTPPassword       string       `json:"SMTPPassword,omitempty"`
+	TLSMode            mail.TLSMode `json:"TLSMode"`
+	InsecureSkipVerify bool         `json:"InsecureSkipVerify"`
+	From               string       `json:"From"`
+	Recipients         string       `json:"Recipients"`
+	SubjectTemplate    string       `json:"SubjectTemplate"`
+	BodyTemplate       string       `json:"BodyTemplate"`
+	ExpiringWithinDays int          `json:"ExpiringWithinDays"`
+	ScheduleEnabled    bool         `json:"ScheduleEnabled"`
+	ScheduleWeekday    time.Weekday `json:"ScheduleWeekday"`
+	ScheduleHour       int          `json:"ScheduleHour"`
+	LastSentAt         *time.Time   `json:"LastSentAt,omitempty"`
+}
+
+// RecipientList splits the comma or semicolon separated Recipients field.
+func (c *DigestConfig) RecipientList() []string {
+	var recipients []string
+	for _, r := range strings.FieldsFunc(c.Recipients, func(r rune) bool { return r == ',' || r == ';' }) {
+		if r = strings.TrimSpace(r); r != "" {
+			recipients = append(recipients, r)
+		}
+	}
+	return recipients
+}
+
+func (c *DigestConfig) SMTPSettings() mail.SMTPSettings {
+	return mail.SMTPSettings{
+		Host:               c.SMTPHost,
+		Port:               c.SMTPPort,
+		Username:           c.SMTPUsername,
+		Password:           c.SMTPPassword,
+		TLSMode:            c.TLSMode,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+}
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/digest/model"
+)
+
+type DigestRepository interface {
+	GetConfig() model.DigestConfig
+	SaveConfig(config model.DigestConfig) error
+}
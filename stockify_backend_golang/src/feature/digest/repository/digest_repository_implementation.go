@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/common/mail"
+	"stockify_backend_golang/src/feature/digest/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.DigestConfig{})
+	if err != nil {
+		log.Fatal("Failed to migrate DigestConfig table: " + err.Error())
+	}
+}
+
+type digestRepository struct{}
+
+func DigestRepositoryImplementation() DigestRepository {
+	return &digestRepository{}
+}
+
+// GetConfig returns the stored configuration, or the defaults if none has
+// been saved yet.
+func (r *digestRepository) GetConfig() model.DigestConfig {
+	var config model.DigestConfig
+	result := db.DB.Order("id").Limit(1).Find(&config)
+	if result.RowsAffected == 0 {
+		return model.DigestConfig{
+			SMTPPort:           587,
+			TLSMode:            mail.TLS_STARTTLS,
+			ExpiringWithinDays: itemmodel.DefaultExpiringWithinDays,
+			ScheduleWeekday:    time.Monday,
+			ScheduleHour:       8,
+		}
+	}
+	return config
+}
+
+func (r *digestRepository) SaveConfig(config model.DigestConfig) error {
+	existing := r.GetConfig()
+	config.ID = existing.ID
+	config.CreatedAt = existing.CreatedAt
+	return db.DB.Save(&config).Error
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"stockify_backend_golang/src/common/mail"
+	"stockify_backend_golang/src/feature/digest/model"
+	"time"
+)
+
+type DigestService interface {
+	GetConfig() model.DigestConfig
+	SaveConfig(config model.DigestConfig) error
+	BuildDigest(now time.Time) (model.Digest, error)
+	RenderDigest(config model.DigestConfig, digest model.Digest) (mail.Message, error)
+	SendDigest(now time.Time) error
+	WriteDigestToFile(now time.Time, outPath string) error
+	IsDue(config model.DigestConfig, now time.Time) bool
+	StartScheduler(interval time.Duration)
+	StopScheduler()
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"stockify_backend_golang/src/common/mail"
+	"stockify_backend_golang/src/feature/digest/model"
+	"stockify_backend_golang/src/feature/digest/repository"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemrepository "stockify_backend_golang/src/feature/item/repository"
+	"sync"
+	"text/template"
+	"time"
+)
+
+const (
+	expiringSectionTitle = "Warranty expiring soon"
+	orphanedSectionTitle = "Assigned to deleted users"
+)
+
+type digestService struct {
+	repo     repository.DigestRepository
+	itemRepo itemrepository.ItemRepository
+	send     func(settings mail.SMTPSettings, message mail.Message) error
+
+	mu   sync.Mutex
+	stop chan struct{}
+}
+
+func DigestServiceImplementation(
+	repo repository.DigestRepository,
+	itemRepo itemrepository.ItemRepository,
+) DigestService {
+	return &digestService{repo: repo, itemRepo: itemRepo, send: mail.Send}
+}
+
+func (s *digestService) GetConfig() model.DigestConfig {
+	return s.repo.GetConfig()
+}
+
+func (s *digestService) SaveConfig(config model.DigestConfig) error {
+	if config.ScheduleHour < 0 || config.ScheduleHour > 23 {
+		return errors.New("schedule hour must be between 0 and 23")
+	}
+	if config.ScheduleWeekday < time.Sunday || config.ScheduleWeekday > time.Saturday {
+		return errors.New("schedule weekday must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	switch config.TLSMode {
+	case mail.TLS_NONE, mail.TLS_STARTTLS, mail.TLS_IMPLICIT:
+	default:
+		return fmt.Errorf("unknown TLS mode %q", config.TLSMode)
+	}
+	for _, tmpl := range []string{config.SubjectTemplate, config.BodyTemplate} {
+		if _, err := parseTemplate(tmpl); err != nil {
+			return err
+		}
+	}
+	// Keep the stored password when the client sends the config back without it
+	if config.SMTPPassword == "" {
+		config.SMTPPassword = s.repo.GetConfig().SMTPPassword
+	}
+	config.LastSentAt = s.repo.GetConfig().LastSentAt
+	return s.repo.SaveConfig(config)
+}
+
+func (s *digestService) BuildDigest(now time.Time) (model.Digest, error) {
+	config := s.repo.GetConfig()
+	withinDays := config.ExpiringWithinDays
+	if withinDays <= 0 {
+		withinDays = itemmodel.DefaultExpiringWithinDays
+	}
+	digest := model.Digest{
+		GeneratedAt:        now,
+		ExpiringWithinDays: withinDays,
+		Sections: []model.DigestSection{
+			{
+				Title: fmt.Sprintf("%s (within %d days)", expiringSectionTitle, withinDays),
+				Filter: itemmodel.ItemFilterParams{
+					IsExpiring:         true,
+					ExpiringWithinDays: withinDays,
+					SortBy:             "warranty_date",
+				},
+			},
+			{
+				Title:  orphanedSectionTitle,
+				Filter: itemmodel.ItemFilterParams{AssignedToDeletedUser: true, SortBy: "asset_no"},
+			},
+		},
+	}
+	for i := range digest.Sections {
+		items, err := s.itemRepo.GetFilteredItems(digest.Sections[i].Filter)
+		if err != nil {
+			return model.Digest{}, err
+		}
+		digest.Sections[i].Items = items
+	}
+	return digest, nil
+}
+
+func (s *digestService) RenderDigest(config model.DigestConfig, digest model.Digest) (mail.Message, error) {
+	data := struct {
+		model.Digest
+		ExpiringCount int
+		OrphanedCount int
+	}{Digest: digest}
+	if len(digest.Sections) > 0 {
+		data.ExpiringCount = len(digest.Sections[0].Items)
+	}
+	if len(digest.Sections) > 1 {
+		data.OrphanedCount = len(digest.Sections[1].Items)
+	}
+
+	subject, err := executeTemplate(config.SubjectTemplate, defaultSubjectTemplate, data)
+	if err != nil {
+		return mail.Message{}, err
+	}
+	body, err := executeTemplate(config.BodyTemplate, defaultBodyTemplate, data)
+	if err != nil {
+		return mail.Message{}, err
+	}
+	return mail.Message{
+		From:     config.From,
+		To:       config.RecipientList(),
+		Subject:  subject,
+		TextBody: body,
+	}, nil
+}
+
+func (s *digestService) SendDigest(now time.Time) error {
+	config := s.repo.GetConfig()
+	if len(config.RecipientList()) == 0 {
+		return errors.New("no digest recipients configured")
+	}
+	if config.From == "" {
+		return errors.New("digest sender address is not configured")
+	}
+	digest, err := s.BuildDigest(now)
+	if err != nil {
+		return err
+	}
+	message, err := s.RenderDigest(config, digest)
+	if err != nil {
+		return err
+	}
+	if err := s.send(config.SMTPSettings(), message); err != nil {
+		return err
+	}
+	config.LastSentAt = &now
+	return s.repo.SaveConfig(config)
+}
+
+// WriteDigestToFile renders the digest exactly as it would be sent and writes
+// the raw email to outPath without contacting the SMTP server.
+func (s *digestService) WriteDigestToFile(now time.Time, outPath string) error {
+	config := s.repo.GetConfig()
+	digest, err := s.BuildDigest(now)
+	if err != nil {
+		return err
+	}
+	message, err := s.RenderDigest(config, digest)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outPath, message.Bytes(now), 0644)
+}
+
+// IsDue reports whether the most recent scheduled slot (weekday and hour) at or
+// before now has not been sent yet.
+func (s *digestService) IsDue(config model.DigestConfig, now time.Time) bool {
+	if !config.ScheduleEnabled {
+		return false
+	}
+	daysSince := (int(now.Weekday()) - int(config.ScheduleWeekday) + 7) % 7
+	slot := time.Date(now.Year(), now.Month(), now.Day(), config.ScheduleHour, 0, 0, 0, now.Location()).
+		AddDate(0, 0, -daysSince)
+	if slot.After(now) {
+		slot = slot.AddDate(0, 0, -7)
+	}
+	return config.LastSentAt == nil || config.LastSentAt.Before(slot)
+}
+
+// StartScheduler checks every interval whether the digest is due and sends it.
+// Calling it again restarts the scheduler with the new interval.
+func (s *digestService) StartScheduler(interval time.Duration) {
+	s.StopScheduler()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stop := make(chan struct{})
+	s.stop = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				if !s.IsDue(s.repo.GetConfig(), now) {
+					continue
+				}
+				if err := s.SendDigest(now); err != nil {
+					log.Println("Failed to send scheduled digest:", err)
+				}
+			}
+		}
+	}()
+}
+
+func (s *digestService) StopScheduler() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	return template.New("digest").Funcs(template.FuncMap{
+		"formatDate": func(t time.Time) string { return t.Format(time.DateOnly) },
+	}).Parse(text)
+}
+
+func executeTemplate(text, fallback string, data interface{}) (string, error) {
+	if text == "" {
+		text = fallback
+	}
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"net"
+	"net/textproto"
+	"stockify_backend_golang/src/common/mail"
+	"stockify_backend_golang/src/feature/digest/model"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemrepository "stockify_backend_golang/src/feature/item/repository"
+	usermodel "stockify_backend_golang/src/feature/user/model"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeDigestRepository struct {
+	config model.DigestConfig
+}
+
+func (r *fakeDigestRepository) GetConfig() model.DigestConfig { return r.config }
+
+func (r *fakeDigestRepository) SaveConfig(config model.DigestConfig) error {
+	r.config = config
+	return nil
+}
+
+// fakeItemRepository answers the digest sections; the embedded interface is
+// left nil as the digest needs nothing else.
+type fakeItemRepository struct {
+	itemrepository.ItemRepository
+	expiring []itemmodel.Item
+	orphaned []itemmodel.Item
+}
+
+func (r *fakeItemRepository) GetFilteredItems(params itemmodel.ItemFilterParams) ([]itemmodel.Item, error) {
+	if params.AssignedToDeletedUser {
+		return r.orphaned, nil
+	}
+	return r.expiring, nil
+}
+
+// receivedMail is what the SMTP listener was handed in one session
+type receivedMail struct {
+	from       string
+	recipients []string
+	data       string
+}
+
+// startSMTPServer accepts a single SMTP session on a local port and passes on
+// what it received once the client quits.
+func startSMTPServer(t *testing.T) (host string, port int, received <-chan receivedMail) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	out := make(chan receivedMail, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		text := textproto.NewConn(conn)
+		var session receivedMail
+		text.PrintfLine("220 localhost ESMTP test")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+			verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+			switch verb {
+			case "EHLO", "HELO":
+				text.PrintfLine("250 localhost")
+			case "MAIL":
+				session.from = addressOf(line)
+				text.PrintfLine("250 OK")
+			case "RCPT":
+				session.recipients = append(session.recipients, addressOf(line))
+				text.PrintfLine("250 OK")
+			case "DATA":
+				text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+				data, err := text.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				session.data = string(data)
+				text.PrintfLine("250 OK")
+			case "QUIT":
+				text.PrintfLine("221 Bye")
+				out <- session
+				return
+			default:
+				text.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	address := listener.Addr().(*net.TCPAddr)
+	return address.IP.String(), address.Port, out
+}
+
+func addressOf(line string) string {
+	start, end := strings.Index(line, "<"), strings.LastIndex(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+func TestSendDigest(t *testing.T) {
+	host, port, received := startSMTPServer(t)
+	repo := &fakeDigestRepository{config: model.DigestConfig{
+		SMTPHost:           host,
+		SMTPPort:           port,
+		TLSMode:            mail.TLS_NONE,
+		From:               "stockify@example.com",
+		Recipients:         "it@example.com; admin@example.com,",
+		ExpiringWithinDays: 30,
+	}}
+	warranty := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	items := &fakeItemRepository{
+		expiring: []itemmodel.Item{{AssetNo: "PC-001", DeviceType: "CPU", ModelNo: "OptiPlex", WarrantyDate: warranty}},
+		orphaned: []itemmodel.Item{{
+			AssetNo:      "MON-002",
+			DeviceType:   "Monitor",
+			ModelNo:      "P2419H",
+			WarrantyDate: warranty,
+			AssignedTo:   &usermodel.User{UserName: "jdoe"},
+		}},
+	}
+	service := DigestServiceImplementation(repo, items)
+
+	now := time.Date(2026, time.February, 2, 8, 0, 0, 0, time.UTC)
+	if err := service.SendDigest(now); err != nil {
+		t.Fatalf("SendDigest: %v", err)
+	}
+
+	var session receivedMail
+	select {
+	case session = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the SMTP listener received nothing")
+	}
+	if session.from != "stockify@example.com" {
+		t.Errorf("MAIL FROM = %q", session.from)
+	}
+	if got := strings.Join(session.recipients, ","); got != "it@example.com,admin@example.com" {
+		t.Errorf("RCPT TO = %s", got)
+	}
+	for _, want := range []string{
+		"To: it@example.com, admin@example.com\n",
+		"Subject: Stockify digest: 1 expiring, 1 assigned to deleted users\n",
+		"Stockify inventory digest generated 2026-02-02\n",
+		"Warranty expiring soon (within 30 days) (1)\n",
+		"  - PC-001 | CPU | OptiPlex | warranty 2026-03-01\n",
+		"Assigned to deleted users (1)\n",
+		"  - MON-002 | Monitor | P2419H | warranty 2026-03-01 | jdoe\n",
+	} {
+		if !strings.Contains(session.data, want) {
+			t.Errorf("message is missing %q:\n%s", want, session.data)
+		}
+	}
+	if repo.config.LastSentAt == nil || !repo.config.LastSentAt.Equal(now) {
+		t.Errorf("LastSentAt = %v, want %v", repo.config.LastSentAt, now)
+	}
+}
+
+func TestSendDigestWithoutRecipients(t *testing.T) {
+	repo := &fakeDigestRepository{config: model.DigestConfig{
+		SMTPHost: "127.0.0.1",
+		SMTPPort: 25,
+		From:     "stockify@example.com",
+	}}
+	service := DigestServiceImplementation(repo, &fakeItemRepository{})
+	if err := service.SendDigest(time.Now()); err == nil {
+		t.Fatal("SendDigest without recipients succeeded")
+	}
+	if repo.config.LastSentAt != nil {
+		t.Error("LastSentAt was set although nothing was sent")
+	}
+}
+
+func TestSendDigestServerUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	repo := &fakeDigestRepository{config: model.DigestConfig{
+		SMTPHost:   "127.0.0.1",
+		SMTPPort:   port,
+		From:       "stockify@example.com",
+		Recipients: "it@example.com",
+	}}
+	service := DigestServiceImplementation(repo, &fakeItemRepository{})
+	if err := service.SendDigest(time.Now()); err == nil {
+		t.Fatalf("SendDigest to port %d succeeded without a server", port)
+	}
+	if repo.config.LastSentAt != nil {
+		t.Error("LastSentAt was set although nothing was sent")
+	}
+}
@@ -0,0 +1,14 @@
+package service
+
+const defaultSubjectTemplate = `Stockify digest: {{.ExpiringCount}} expiring, {{.OrphanedCount}} assigned to deleted users`
+
+const defaultBodyTemplate = `Stockify inventory digest generated {{formatDate .GeneratedAt}}
+{{range .Sections}}
+{{.Title}} ({{len .Items}})
+{{- if not .Items}}
+  None
+{{- end}}
+{{- range .Items}}
+  - {{.AssetNo}} | {{.DeviceType}} | {{.ModelNo}} | warranty {{formatDate .WarrantyDate}}{{if .AssignedTo}} | {{.AssignedTo.UserName}}{{end}}
+{{- end}}
+{{end}}`
@@ -3,7 +3,20 @@ package model
 type AssetStatus string
 
 const (
+	ORDERED   AssetStatus = "Ordered"
+	IN_STOCK  AssetStatus = "In Stock"
+	DEPLOYED  AssetStatus = "Deployed"
+	IN_REPAIR AssetStatus = "In Repair"
+	LOST      AssetStatus = "Lost"
+	RETIRED   AssetStatus = "Retired"
+	DISPOSED  AssetStatus = "Disposed"
+
+	// Statuses from before the lifecycle was introduced, kept for existing items
 	ACTIVE   AssetStatus = "Active"
 	INACTIVE AssetStatus = "Inactive"
-	DISPOSED AssetStatus = "Disposed"
 )
+
+// AssetStatuses lists the asset statuses in display order
+var AssetStatuses = []AssetStatus{
+	ORDERED, IN_STOCK, DEPLOYED, IN_REPAIR, LOST, RETIRED, DISPOSED, ACTIVE, INACTIVE,
+}
@@ -1,5 +1,7 @@
 package model
 
+// DeviceType is the code of an entry in the device type catalog. The
+// constants below are the built-in entries seeded into an empty catalog.
 type DeviceType string
 
 const (
@@ -20,3 +22,9 @@ const (
 	MOUSE     DeviceType = "Mouse"
 	SPEAKER   DeviceType = "Speaker"
 )
+
+// DeviceTypes lists the built-in device types in catalog order
+var DeviceTypes = []DeviceType{
+	CPU, MONITOR, UPS, RAM, HDD, SSD, PRINTER, SCANNER,
+	PROJECTOR, ROUTER, MODEM, SWITCH, CAMERA, KEYBOARD, MOUSE, SPEAKER,
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"fmt"
+	customfieldmodel "stockify_backend_golang/src/feature/customfield/model"
+	notemodel "stockify_backend_golang/src/feature/note/model"
+	tagmodel "stockify_backend_golang/src/feature/tag/model"
 	"stockify_backend_golang/src/feature/user/model"
 	"strings"
 	"time"
@@ -14,11 +17,12 @@ type Item struct {
 	ID              uint64      `gorm:"primaryKey;autoIncrement" json:"ID"`
 	AssetNo         string      `json:"AssetNo"`
 	ModelNo         string      `json:"ModelNo"`
-	DeviceType      DeviceType  `json:"DeviceType"`
+	DeviceType      DeviceType  `gorm:"index" json:"DeviceType"`
+	DeviceTypeID    *uint64     `gorm:"index" json:"DeviceTypeID,omitempty"`
 	SerialNo        string      `json:"SerialNo"`
 	ReceivedDate    *time.Time  `json:"ReceivedDate,omitempty"`
-	WarrantyDate    time.Time   `json:"WarrantyDate"`
-	AssetStatus     AssetStatus `json:"AssetStatus"`
+	WarrantyDate    time.Time   `gorm:"index" json:"WarrantyDate"`
+	AssetStatus     AssetStatus `gorm:"index" json:"AssetStatus"`
 	HostName        *string     `json:"HostName,omitempty"`
 	IpPort          *string     `json:"IpPort,omitempty"`
 	MacAddress      *string     `json:"MacAddress,omitempty"`
@@ -26,8 +30,31 @@ type Item struct {
 	FacePlateName   *string     `json:"FacePlateName,omitempty"`
 	SwitchPort      *string     `json:"SwitchPort,omitempty"`
 	SwitchIpAddress *string     `json:"SwitchIpAddress,omitempty"`
-	AssignedToID    *uint64     `json:"AssignedToID,omitempty"`
+	AssignedToID    *uint64     `gorm:"index" json:"AssignedToID,omitempty"`
 	AssignedTo      *model.User `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;foreignKey:AssignedToID" json:"AssignedTo,omitempty"`
+
+	// Values of the custom attributes defined for the item's device type
+	Attributes []customfieldmodel.ItemAttributeValue `gorm:"foreignKey:ItemID" json:"Attributes,omitempty"`
+
+	// Where the item is kept, a node of the location hierarchy
+	LocationID *uint64 `gorm:"index" json:"LocationID,omitempty"`
+
+	// Purchase details, see the purchasing feature
+	VendorID        *uint64  `gorm:"index" json:"VendorID,omitempty"`
+	PurchaseOrderID *uint64  `gorm:"index" json:"PurchaseOrderID,omitempty"`
+	PurchasePrice   *float64 `json:"PurchasePrice,omitempty"`
+	Currency        *string  `json:"Currency,omitempty"`
+	InvoiceNo       *string  `json:"InvoiceNo,omitempty"`
+
+	// The item this one is built into, e.g. the workstation holding a RAM module
+	ParentID *uint64 `gorm:"index" json:"ParentID,omitempty"`
+
+	// Tags of the item, managed through the tag feature
+	Tags []tagmodel.Tag `gorm:"many2many:item_tags" json:"Tags,omitempty"`
+
+	// Notes on the item, oldest first, filled in when items are listed and
+	// managed through the note feature
+	Notes []notemodel.Note `gorm:"foreignKey:ItemID" json:"Notes,omitempty"`
 }
 
 func (i *Item) String() string {
@@ -0,0 +1,17 @@
+package model
+
+import "stockify_backend_golang/src/common/event"
+
+const (
+	ITEM_UPDATED          event.Type = "item.updated"
+	ITEM_DELETED          event.Type = "item.deleted"
+	ITEM_DISPOSED         event.Type = "item.disposed"
+	ITEM_ASSIGNEE_CHANGED event.Type = "item.assignee_changed"
+)
+
+// ItemEvent is the data published with item events. Previous holds the item as
+// it was before the change.
+type ItemEvent struct {
+	Item     Item  `json:"item"`
+	Previous *Item `json:"previous,omitempty"`
+}
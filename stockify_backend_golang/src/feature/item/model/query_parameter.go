@@ -8,6 +8,20 @@ const (
 	Year  WarrantyDateFilterType = "year"
 )
 
+// DefaultExpiringWithinDays is used for IsExpiring when ExpiringWithinDays is not set
+const DefaultExpiringWithinDays = 30
+
+// SortColumns are the item columns SortBy may name
+var SortColumns = map[string]bool{
+	"asset_no":      true,
+	"model_no":      true,
+	"serial_no":     true,
+	"device_type":   true,
+	"asset_status":  true,
+	"received_date": true,
+	"warranty_date": true,
+}
+
 type ItemFilterParams struct {
 	Search                 string
 	DeviceType             *DeviceType
@@ -16,7 +30,39 @@ type ItemFilterParams struct {
 	WarrantyDate           *int64
 	WarrantyDateFilterType *WarrantyDateFilterType
 	IsExpiring             bool
+	ExpiringWithinDays     int
 	IsExpired              bool
-	SortBy                 string
-	SortOrder              string
+	AssignedToDeletedUser  bool
+	AttributeFilters       []AttributeFilter
+	LocationID             *uint64  // the location or anywhere below it
+	HolderOrgUnitID        *uint64  // held by a user of the org unit or any unit below it
+	HolderFloor            *string  // held by a user on the floor
+	InsideItemID           *uint64  // components of the item, at any depth
+	AnyTags                []string // carrying at least one of the tags
+	AllTags                []string // carrying every one of the tags
+	NoTags                 []string // carrying none of the tags
+	SavedSearchID          *uint64  // also matching the saved search
+	SortBy                 string   // one of SortColumns
+	SortOrder              string   // ASC or DESC
+}
+
+type AttributeFilterOperator string
+
+const (
+	EQUALS           AttributeFilterOperator = "eq"
+	NOT_EQUALS       AttributeFilterOperator = "neq"
+	GREATER_THAN     AttributeFilterOperator = "gt"
+	GREATER_OR_EQUAL AttributeFilterOperator = "gte"
+	LESS_THAN        AttributeFilterOperator = "lt"
+	LESS_OR_EQUAL    AttributeFilterOperator = "lte"
+	CONTAINS         AttributeFilterOperator = "contains"
+)
+
+// AttributeFilter matches items whose custom attribute Key compares to Value.
+// Range operators compare numerically when Value is a number and as text
+// otherwise, which orders YYYY-MM-DD dates correctly.
+type AttributeFilter struct {
+	Key      string
+	Operator AttributeFilterOperator
+	Value    string
 }
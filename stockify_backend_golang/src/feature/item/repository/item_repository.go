@@ -1,6 +1,7 @@
 package repository
 
 import (
+	customfieldmodel "stockify_backend_golang/src/feature/customfield/model"
 	"stockify_backend_golang/src/feature/item/model"
 )
 
@@ -9,6 +10,7 @@ type ItemRepository interface {
 	GetItemById(id uint64) model.Item
 	AddItem(item model.Item)
 	UpdateItem(item model.Item)
+	UpdateItemWithAttributes(item model.Item, values []customfieldmodel.ItemAttributeValue) error
 	DeleteItemById(id uint64)
 	GetFilteredItems(params model.ItemFilterParams) ([]model.Item, error)
 }
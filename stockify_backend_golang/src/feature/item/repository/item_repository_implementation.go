@@ -1,17 +1,68 @@
 package repository
 
 import (
+	"errors"
 	"log"
 	"stockify_backend_golang/src/common/db"
+	componentrepository "stockify_backend_golang/src/feature/component/repository"
+	customfieldmodel "stockify_backend_golang/src/feature/customfield/model"
+	devicetyperepository "stockify_backend_golang/src/feature/devicetype/repository"
 	"stockify_backend_golang/src/feature/item/model"
+	locationrepository "stockify_backend_golang/src/feature/location/repository"
+	noterepository "stockify_backend_golang/src/feature/note/repository"
+	orgunitrepository "stockify_backend_golang/src/feature/orgunit/repository"
+	savedsearchrepository "stockify_backend_golang/src/feature/savedsearch/repository"
+	tagmodel "stockify_backend_golang/src/feature/tag/model"
+	tagrepository "stockify_backend_golang/src/feature/tag/repository"
+	usermodel "stockify_backend_golang/src/feature/user/model"
+	"strconv"
+	"strings"
 	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func init() {
-	err := db.DB.AutoMigrate(&model.Item{})
+	err := db.DB.AutoMigrate(&model.Item{}, &customfieldmodel.ItemAttributeValue{})
 	if err != nil {
 		log.Fatal("Failed to migrate Item table: " + err.Error())
 	}
+	devicetyperepository.LinkItemsToCatalog()
+	if err := stripStatusPrefix(); err != nil {
+		log.Fatal("Failed to fix asset statuses stored by the app: " + err.Error())
+	}
+}
+
+// statusPrefix is what the app used to put in front of asset statuses, as it
+// sent the Dart enum's toString() rather than its name
+const statusPrefix = "AssetStatus."
+
+// statusColumns are the columns, by table, that hold an asset status
+var statusColumns = map[string][]string{
+	"items":               {"asset_status"},
+	"status_histories":    {"from_status", "to_status"},
+	"maintenance_tickets": {"status_before"},
+}
+
+// stripStatusPrefix rewrites the asset statuses stored with statusPrefix to
+// the plain status names the rest of the backend compares against.
+func stripStatusPrefix() error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		for table, columns := range statusColumns {
+			if !tx.Migrator().HasTable(table) {
+				continue
+			}
+			for _, column := range columns {
+				err := tx.Exec("UPDATE "+table+" SET "+column+" = SUBSTR("+column+", ?) WHERE "+column+" LIKE ?",
+					len(statusPrefix)+1, statusPrefix+"%").Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
 }
 
 type itemRepository struct{}
@@ -21,23 +72,46 @@ func ItemRepositoryImplementation() ItemRepository {
 }
 
 func (r *itemRepository) AddItem(item model.Item) {
-	db.DB.Create(&item)
+	// Tags are put on through the tag feature, which keeps their names
+	// normalized, and notes through the note feature
+	db.DB.Omit("Tags", "Notes").Create(&item)
 }
 
 func (r *itemRepository) GetAllItems() []model.Item {
 	var items []model.Item
-	db.DB.Preload("AssignedTo").Find(&items)
+	db.DB.Preload("AssignedTo").Preload("Attributes").Preload("Tags").Preload("Notes", noterepository.OldestFirst).Find(&items)
 	return items
 }
 
 func (r *itemRepository) GetItemById(id uint64) model.Item {
 	var item model.Item
-	db.DB.Preload("AssignedTo").First(&item, id)
+	db.DB.Preload("AssignedTo").Preload("Attributes").Preload("Tags").First(&item, id)
 	return item
 }
 
 func (r *itemRepository) UpdateItem(item model.Item) {
-	db.DB.Save(&item)
+	// Associations are managed by their own features, never through the item
+	db.DB.Omit(clause.Associations).Save(&item)
+}
+
+// UpdateItemWithAttributes saves the item and replaces its custom attribute
+// values in one transaction.
+func (r *itemRepository) UpdateItemWithAttributes(item model.Item, values []customfieldmodel.ItemAttributeValue) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(&item).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("item_id = ?", item.ID).Delete(&customfieldmodel.ItemAttributeValue{}).Error; err != nil {
+			return err
+		}
+		for i := range values {
+			values[i].ItemID = item.ID
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		return tx.Create(&values).Error
+	})
 }
 
 func (r *itemRepository) DeleteItemById(id uint64) {
@@ -51,13 +125,58 @@ func (r *itemRepository) DeleteItemById(id uint64) {
 }
 
 func (r *itemRepository) GetFilteredItems(params model.ItemFilterParams) ([]model.Item, error) {
-	query := db.DB.Model(&model.Item{}).Preload("AssignedTo")
+	query := applyFilters(db.DB.Model(&model.Item{}), params)
+
+	// A saved search narrows the result further and sorts it unless params do
+	if params.SavedSearchID != nil && *params.SavedSearchID != 0 {
+		saved, ok := savedsearchrepository.SavedFilter(*params.SavedSearchID)
+		if !ok {
+			return nil, errors.New("saved search not found")
+		}
+		// A saved filter naming another saved search is not followed
+		saved.SavedSearchID = nil
+		query = applyFilters(query, saved)
+		params.AssignedToDeletedUser = params.AssignedToDeletedUser || saved.AssignedToDeletedUser
+		if params.SortBy == "" {
+			params.SortBy, params.SortOrder = saved.SortBy, saved.SortOrder
+		}
+	}
+
+	if params.AssignedToDeletedUser {
+		// Keep the deleted assignee visible so the orphaned item can be reported
+		query = query.Preload("AssignedTo", func(tx *gorm.DB) *gorm.DB {
+			return tx.Unscoped()
+		})
+	} else {
+		query = query.Preload("AssignedTo")
+	}
+
+	// Sorting
+	if model.SortColumns[params.SortBy] {
+		order := "ASC"
+		if strings.EqualFold(params.SortOrder, "DESC") {
+			order = "DESC"
+		}
+		query = query.Order(params.SortBy + " " + order)
+	}
+
+	// Execute
+	var items []model.Item
+	if err := query.Preload("Attributes").Preload("Tags").Preload("Notes", noterepository.OldestFirst).Find(&items).Error; err != nil {
+		return nil, err
+	}
 
+	return items, nil
+}
+
+// applyFilters adds the conditions of every filter set in params to query
+func applyFilters(query *gorm.DB, params model.ItemFilterParams) *gorm.DB {
 	// Search filter
 	if params.Search != "" {
 		search := "%" + params.Search + "%"
-		query = query.Where("LOWER(asset_no) LIKE LOWER(?) OR LOWER(model_no) LIKE LOWER(?) OR LOWER(serial_no) LIKE LOWER(?)",
-			search, search, search)
+		query = query.Where("LOWER(asset_no) LIKE LOWER(?) OR LOWER(model_no) LIKE LOWER(?) OR LOWER(serial_no) LIKE LOWER(?)"+
+			" OR id IN ("+noterepository.ItemSearchQuery+")",
+			search, search, search, search)
 	}
 
 	// Device type filter
@@ -93,14 +212,18 @@ func (r *itemRepository) GetFilteredItems(params model.ItemFilterParams) ([]mode
 		}
 	}
 
-	// IsExpiring filter (within 30 days but NOT yet expired)
+	// IsExpiring filter (within ExpiringWithinDays, 30 by default, but NOT yet expired)
 	if params.IsExpiring {
+		withinDays := params.ExpiringWithinDays
+		if withinDays <= 0 {
+			withinDays = model.DefaultExpiringWithinDays
+		}
 		now := time.Now()
 		// Normalize to start of today (ignore time)
 		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-		thirtyDaysFromNow := today.AddDate(0, 0, 30)
-		// Compare only dates: warranty_date > today AND warranty_date <= today+30
-		query = query.Where("DATE(warranty_date) > DATE(?) AND DATE(warranty_date) <= DATE(?)", today, thirtyDaysFromNow)
+		windowEnd := today.AddDate(0, 0, withinDays)
+		// Compare only dates: warranty_date > today AND warranty_date <= today+withinDays
+		query = query.Where("DATE(warranty_date) > DATE(?) AND DATE(warranty_date) <= DATE(?)", today, windowEnd)
 	}
 
 	// IsExpired filter
@@ -110,20 +233,80 @@ func (r *itemRepository) GetFilteredItems(params model.ItemFilterParams) ([]mode
 		query = query.Where("DATE(warranty_date) < DATE(?)", today)
 	}
 
-	// Sorting
-	if params.SortBy != "" {
-		order := "ASC"
-		if params.SortOrder != "" {
-			order = params.SortOrder
-		}
-		query = query.Order(params.SortBy + " " + order)
+	// Assigned to a user that has since been deleted
+	if params.AssignedToDeletedUser {
+		query = query.Where("assigned_to_id IN (?)",
+			db.DB.Unscoped().Model(&usermodel.User{}).Select("id").Where("deleted_at IS NOT NULL"))
 	}
 
-	// Execute
-	var items []model.Item
-	if err := query.Find(&items).Error; err != nil {
-		return nil, err
+	// Holder's floor
+	if params.HolderFloor != nil && *params.HolderFloor != "" {
+		query = query.Where("assigned_to_id IN (SELECT id FROM users WHERE floor = ? COLLATE NOCASE AND deleted_at IS NULL)",
+			*params.HolderFloor)
 	}
 
-	return items, nil
+	// Location filter, including everything below the location
+	if params.LocationID != nil && *params.LocationID != 0 {
+		query = query.Where("location_id IN ("+locationrepository.SubtreeQuery+")", *params.LocationID)
+	}
+
+	// Holder's org unit filter, including every unit below it
+	if params.HolderOrgUnitID != nil && *params.HolderOrgUnitID != 0 {
+		query = query.Where("assigned_to_id IN ("+orgunitrepository.HolderQuery+")", *params.HolderOrgUnitID)
+	}
+
+	// Components of an item, at any depth
+	if params.InsideItemID != nil && *params.InsideItemID != 0 {
+		query = query.Where("id IN ("+componentrepository.ComponentsQuery+")", *params.InsideItemID)
+	}
+
+	// Tag filters
+	if tags := tagmodel.NormalizeTagNames(params.AnyTags); len(tags) > 0 {
+		query = query.Where("id IN ("+tagrepository.TaggedAnyQuery+")", tags)
+	}
+	if tags := tagmodel.NormalizeTagNames(params.AllTags); len(tags) > 0 {
+		query = query.Where("id IN ("+tagrepository.TaggedAllQuery+")", tags, len(tags))
+	}
+	if tags := tagmodel.NormalizeTagNames(params.NoTags); len(tags) > 0 {
+		query = query.Where("id NOT IN ("+tagrepository.TaggedAnyQuery+")", tags)
+	}
+
+	// Custom attribute filters
+	for _, filter := range params.AttributeFilters {
+		condition, args := attributeCondition(filter)
+		query = query.Where("id IN (SELECT item_id FROM item_attribute_values WHERE key = ? AND "+condition+")",
+			append([]interface{}{filter.Key}, args...)...)
+	}
+
+	return query
+}
+
+func attributeCondition(filter model.AttributeFilter) (string, []interface{}) {
+	number, err := strconv.ParseFloat(filter.Value, 64)
+	isNumber := err == nil
+	comparisons := map[model.AttributeFilterOperator]string{
+		model.GREATER_THAN:     ">",
+		model.GREATER_OR_EQUAL: ">=",
+		model.LESS_THAN:        "<",
+		model.LESS_OR_EQUAL:    "<=",
+	}
+	switch filter.Operator {
+	case model.NOT_EQUALS:
+		if isNumber {
+			return "NOT (value = ? OR number_value = ?)", []interface{}{filter.Value, number}
+		}
+		return "value <> ?", []interface{}{filter.Value}
+	case model.CONTAINS:
+		return "LOWER(value) LIKE LOWER(?)", []interface{}{"%" + filter.Value + "%"}
+	case model.GREATER_THAN, model.GREATER_OR_EQUAL, model.LESS_THAN, model.LESS_OR_EQUAL:
+		if isNumber {
+			return "number_value " + comparisons[filter.Operator] + " ?", []interface{}{number}
+		}
+		return "value " + comparisons[filter.Operator] + " ?", []interface{}{filter.Value}
+	default:
+		if isNumber {
+			return "(value = ? OR number_value = ?)", []interface{}{filter.Value, number}
+		}
+		return "value = ?", []interface{}{filter.Value}
+	}
 }
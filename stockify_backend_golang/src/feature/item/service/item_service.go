@@ -3,10 +3,11 @@ package service
 import "stockify_backend_golang/src/feature/item/model"
 
 type ItemService interface {
-	AddItem(item model.Item)
+	AddItem(item model.Item) error
 	GetAllItems() []model.Item
 	GetItemById(id uint64) model.Item
-	UpdateItem(item model.Item)
+	UpdateItem(item model.Item) error
+	TransitionStatus(id uint64, to model.AssetStatus, fields map[string]string, note string) error
 	DeleteItemById(id uint64)
 	GetFilteredItems(params model.ItemFilterParams) ([]model.Item, error)
 }
@@ -1,20 +1,56 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"stockify_backend_golang/src/common/event"
+	customfieldmodel "stockify_backend_golang/src/feature/customfield/model"
+	customfieldrepository "stockify_backend_golang/src/feature/customfield/repository"
+	customfieldservice "stockify_backend_golang/src/feature/customfield/service"
+	devicetyperepository "stockify_backend_golang/src/feature/devicetype/repository"
 	"stockify_backend_golang/src/feature/item/model"
 	"stockify_backend_golang/src/feature/item/repository"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	lifecycleservice "stockify_backend_golang/src/feature/lifecycle/service"
+	networkmodel "stockify_backend_golang/src/feature/network/model"
 )
 
 type itemService struct {
-	repo repository.ItemRepository
+	repo             repository.ItemRepository
+	deviceTypeRepo   devicetyperepository.DeviceTypeRepository
+	customFieldRepo  customfieldrepository.CustomFieldRepository
+	lifecycleService lifecycleservice.LifecycleService
 }
 
-func ItemServiceImplementation(repo repository.ItemRepository) ItemService {
-	return &itemService{repo: repo}
+func ItemServiceImplementation(
+	repo repository.ItemRepository,
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository,
+	customFieldRepo customfieldrepository.CustomFieldRepository,
+	lifecycleService lifecycleservice.LifecycleService,
+) ItemService {
+	return &itemService{
+		repo:             repo,
+		deviceTypeRepo:   deviceTypeRepo,
+		customFieldRepo:  customFieldRepo,
+		lifecycleService: lifecycleService,
+	}
 }
 
-func (s *itemService) AddItem(item model.Item) {
+// AddItem saves a new item with the custom attribute values it carries,
+// which have to include every attribute its device type requires.
+func (s *itemService) AddItem(item model.Item) error {
+	if err := s.linkDeviceType(&item); err != nil {
+		return err
+	}
+	attributes, err := s.checkAttributes(item, item.Attributes, false)
+	if err != nil {
+		return err
+	}
+	item.Attributes = attributes
+	networkmodel.NormalizeItemAddresses(&item)
 	s.repo.AddItem(item)
+	return nil
 }
 
 func (s *itemService) GetAllItems() []model.Item {
@@ -25,14 +61,144 @@ func (s *itemService) GetItemById(id uint64) model.Item {
 	return s.repo.GetItemById(id)
 }
 
-func (s *itemService) UpdateItem(item model.Item) {
-	s.repo.UpdateItem(item)
+// UpdateItem saves the item, rejecting a status change the lifecycle does not
+// allow without transition fields. Use TransitionStatus to supply them.
+// Attributes, when given, replace the item's custom attribute values; when
+// left out the stored values are kept. A new device type drops the values of
+// attributes it does not define and must find its required ones filled in.
+func (s *itemService) UpdateItem(item model.Item) error {
+	return s.updateItem(item, nil, "")
+}
+
+// TransitionStatus moves an item to a new status, recording the transition
+// fields and note in the item's status history.
+func (s *itemService) TransitionStatus(id uint64, to model.AssetStatus, fields map[string]string, note string) error {
+	item := s.repo.GetItemById(id)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	item.AssetStatus = to
+	return s.updateItem(item, fields, note)
+}
+
+func (s *itemService) updateItem(item model.Item, fields map[string]string, note string) error {
+	previous := s.repo.GetItemById(item.ID)
+	if previous.ID == 0 {
+		return errors.New("item not found")
+	}
+	if err := s.lifecycleService.CheckTransition(previous.AssetStatus, item.AssetStatus, fields); err != nil {
+		return err
+	}
+	if err := s.linkDeviceType(&item); err != nil {
+		return err
+	}
+	networkmodel.NormalizeItemAddresses(&item)
+	typeChanged := !sameId(previous.DeviceTypeID, item.DeviceTypeID)
+	attributes := item.Attributes
+	if attributes == nil {
+		attributes = previous.Attributes
+	}
+	if typeChanged || !maps.Equal(attributeValues(attributes), attributeValues(previous.Attributes)) {
+		checked, err := s.checkAttributes(item, attributes, typeChanged)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.UpdateItemWithAttributes(item, checked); err != nil {
+			return err
+		}
+	} else {
+		// Unchanged values are not checked again, so an attribute made
+		// required later does not hold up unrelated edits
+		s.repo.UpdateItem(item)
+	}
+	if previous.AssetStatus != item.AssetStatus {
+		err := s.lifecycleService.RecordTransition(lifecyclemodel.StatusHistory{
+			ItemID:     item.ID,
+			FromStatus: previous.AssetStatus,
+			ToStatus:   item.AssetStatus,
+			Fields:     fields,
+			Note:       note,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	updated := s.repo.GetItemById(item.ID)
+	payload := model.ItemEvent{Item: updated, Previous: &previous}
+	event.Publish(model.ITEM_UPDATED, payload)
+	if updated.AssetStatus == model.DISPOSED && previous.AssetStatus != model.DISPOSED {
+		event.Publish(model.ITEM_DISPOSED, payload)
+	}
+	if !sameId(previous.AssignedToID, updated.AssignedToID) {
+		event.Publish(model.ITEM_ASSIGNEE_CHANGED, payload)
+	}
+	return nil
 }
 
 func (s *itemService) DeleteItemById(id uint64) {
+	item := s.repo.GetItemById(id)
 	s.repo.DeleteItemById(id)
+	event.Publish(model.ITEM_DELETED, model.ItemEvent{Item: item})
 }
 
 func (s *itemService) GetFilteredItems(params model.ItemFilterParams) ([]model.Item, error) {
 	return s.repo.GetFilteredItems(params)
 }
+
+// linkDeviceType points the item at the catalog entry for its device type
+// code. Device types have to be added to the catalog before items use them.
+func (s *itemService) linkDeviceType(item *model.Item) error {
+	if item.DeviceType == "" {
+		item.DeviceTypeID = nil
+		return nil
+	}
+	definition := s.deviceTypeRepo.GetDeviceTypeByCode(string(item.DeviceType))
+	if definition.ID == 0 {
+		return fmt.Errorf("unknown device type %s", item.DeviceType)
+	}
+	item.DeviceTypeID = &definition.ID
+	return nil
+}
+
+// checkAttributes validates custom attribute values against the definitions
+// of the item's device type and returns them normalized for storage. With
+// dropUndefined, values of attributes the type does not define are left out
+// instead of rejected.
+func (s *itemService) checkAttributes(
+	item model.Item,
+	values []customfieldmodel.ItemAttributeValue,
+	dropUndefined bool,
+) ([]customfieldmodel.ItemAttributeValue, error) {
+	var definitions []customfieldmodel.AttributeDefinition
+	if item.DeviceTypeID != nil {
+		definitions = s.customFieldRepo.GetDefinitionsByDeviceTypeId(*item.DeviceTypeID)
+	}
+	defined := map[string]bool{}
+	for _, definition := range definitions {
+		defined[definition.Key] = true
+	}
+	raw := map[string]string{}
+	for key, value := range attributeValues(values) {
+		if dropUndefined && !defined[key] {
+			continue
+		}
+		raw[key] = value
+	}
+	return customfieldservice.ValidateValues(definitions, raw)
+}
+
+func attributeValues(values []customfieldmodel.ItemAttributeValue) map[string]string {
+	byKey := make(map[string]string, len(values))
+	for _, value := range values {
+		byKey[value.Key] = value.Value
+	}
+	return byKey
+}
+
+func sameId(a, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
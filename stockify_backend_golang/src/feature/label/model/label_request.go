@@ -0,0 +1,21 @@
+package model
+
+import itemmodel "stockify_backend_golang/src/feature/item/model"
+
+// LabelRequest selects the items to label and how. Items are either listed
+// by ID, in print order, or selected with a filter. Empty fields fall back to
+// the LabelSettings defaults; CustomTemplate overrides Template for sheets
+// that are not built in.
+type LabelRequest struct {
+	ItemIDs        []uint64                    `json:"ItemIDs"`
+	Filter         *itemmodel.ItemFilterParams `json:"Filter,omitempty"`
+	Template       string                      `json:"Template"`
+	CustomTemplate *LabelTemplate              `json:"CustomTemplate,omitempty"`
+	Symbology      Symbology                   `json:"Symbology"`
+	Format         LabelFormat                 `json:"Format"`
+	// Labels to leave blank at the start of the first sheet, to reuse a
+	// partly used sheet
+	Skip int `json:"Skip"`
+	// Draw the outline of every label, for checking alignment on plain paper
+	Outline bool `json:"Outline"`
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"net/url"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Symbology is the kind of code printed on a label
+type Symbology string
+
+const (
+	QR_CODE  Symbology = "qr"
+	CODE_128 Symbology = "code128"
+)
+
+var Symbologies = []Symbology{QR_CODE, CODE_128}
+
+// LabelFormat is the file format labels are written in
+type LabelFormat string
+
+const (
+	PDF LabelFormat = "pdf"
+	PNG LabelFormat = "png" // one image per sheet at 300 dpi
+)
+
+// Placeholders a URL template may use, replaced by the URL-escaped value
+var URLPlaceholders = []string{"{AssetNo}", "{ID}", "{SerialNo}"}
+
+// LabelSettings is a single-row table with the label defaults. With a URL
+// template QR codes encode a link to the item, e.g.
+// https://inventory.example.com/items/{AssetNo}; without one they encode the
+// asset number. Barcodes always encode the asset number, a URL would make
+// them too wide for a label.
+type LabelSettings struct {
+	gorm.Model
+	ID               uint64    `gorm:"primaryKey;autoIncrement" json:"ID"`
+	URLTemplate      string    `json:"URLTemplate"`
+	DefaultTemplate  string    `json:"DefaultTemplate"`
+	DefaultSymbology Symbology `json:"DefaultSymbology"`
+}
+
+// Payload returns the text encoded in the code of the item's label
+func (s *LabelSettings) Payload(item itemmodel.Item, symbology Symbology) string {
+	if s.URLTemplate == "" || symbology != QR_CODE {
+		return item.AssetNo
+	}
+	return strings.NewReplacer(
+		"{AssetNo}", url.PathEscape(item.AssetNo),
+		"{ID}", strconv.FormatUint(item.ID, 10),
+		"{SerialNo}", url.PathEscape(item.SerialNo),
+	).Replace(s.URLTemplate)
+}
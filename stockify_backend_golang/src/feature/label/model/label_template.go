@@ -0,0 +1,84 @@
+package model
+
+import "fmt"
+
+// LabelTemplate describes a sheet of labels laid out in a regular grid, as
+// sold by Avery and compatible brands. All lengths are in millimetres; the
+// margins are measured to the first label and the gaps between labels.
+type LabelTemplate struct {
+	Code        string  `json:"Code"`
+	Name        string  `json:"Name"`
+	PageWidth   float64 `json:"PageWidth"`
+	PageHeight  float64 `json:"PageHeight"`
+	Columns     int     `json:"Columns"`
+	Rows        int     `json:"Rows"`
+	LabelWidth  float64 `json:"LabelWidth"`
+	LabelHeight float64 `json:"LabelHeight"`
+	MarginLeft  float64 `json:"MarginLeft"`
+	MarginTop   float64 `json:"MarginTop"`
+	GapX        float64 `json:"GapX"`
+	GapY        float64 `json:"GapY"`
+}
+
+// SingleLabelTemplate is the code of the one-label page used for printing a
+// single item's label
+const SingleLabelTemplate = "SINGLE-62x29"
+
+// LabelTemplates are the built-in sheets. A single-label template prints one
+// label per page, for label printers fed from a roll.
+var LabelTemplates = []LabelTemplate{
+	{Code: "L7160", Name: "Avery L7160, A4, 21 per sheet", PageWidth: 210, PageHeight: 297,
+		Columns: 3, Rows: 7, LabelWidth: 63.5, LabelHeight: 38.1, MarginLeft: 7.21, MarginTop: 15.15, GapX: 2.54},
+	{Code: "L7163", Name: "Avery L7163, A4, 14 per sheet", PageWidth: 210, PageHeight: 297,
+		Columns: 2, Rows: 7, LabelWidth: 99.1, LabelHeight: 38.1, MarginLeft: 4.65, MarginTop: 15.15, GapX: 2.5},
+	{Code: "L7651", Name: "Avery L7651, A4, 65 per sheet", PageWidth: 210, PageHeight: 297,
+		Columns: 5, Rows: 13, LabelWidth: 38.1, LabelHeight: 21.2, MarginLeft: 4.75, MarginTop: 10.7, GapX: 2.5},
+	{Code: "5160", Name: "Avery 5160, Letter, 30 per sheet", PageWidth: 215.9, PageHeight: 279.4,
+		Columns: 3, Rows: 10, LabelWidth: 66.675, LabelHeight: 25.4, MarginLeft: 4.7625, MarginTop: 12.7, GapX: 3.175},
+	{Code: "5163", Name: "Avery 5163, Letter, 10 per sheet", PageWidth: 215.9, PageHeight: 279.4,
+		Columns: 2, Rows: 5, LabelWidth: 101.6, LabelHeight: 50.8, MarginLeft: 3.97, MarginTop: 12.7, GapX: 3.97},
+	{Code: SingleLabelTemplate, Name: "Single label 62 x 29 mm", PageWidth: 62, PageHeight: 29,
+		Columns: 1, Rows: 1, LabelWidth: 62, LabelHeight: 29},
+}
+
+// FindLabelTemplate returns the built-in template with the given code
+func FindLabelTemplate(code string) (LabelTemplate, bool) {
+	for _, template := range LabelTemplates {
+		if template.Code == code {
+			return template, true
+		}
+	}
+	return LabelTemplate{}, false
+}
+
+func (t *LabelTemplate) PerPage() int {
+	return t.Columns * t.Rows
+}
+
+// Position returns the top-left corner of the label at index i on its page,
+// counting left to right and then top to bottom.
+func (t *LabelTemplate) Position(i int) (x, y float64) {
+	column, row := i%t.Columns, i/t.Columns
+	return t.MarginLeft + float64(column)*(t.LabelWidth+t.GapX),
+		t.MarginTop + float64(row)*(t.LabelHeight+t.GapY)
+}
+
+// Validate checks that the grid is sensible and fits on the page
+func (t *LabelTemplate) Validate() error {
+	if t.Columns < 1 || t.Rows < 1 {
+		return fmt.Errorf("label template %q needs at least one row and column", t.Code)
+	}
+	if t.LabelWidth <= 0 || t.LabelHeight <= 0 || t.PageWidth <= 0 || t.PageHeight <= 0 {
+		return fmt.Errorf("label template %q has no size", t.Code)
+	}
+	right := t.MarginLeft + float64(t.Columns)*t.LabelWidth + float64(t.Columns-1)*t.GapX
+	bottom := t.MarginTop + float64(t.Rows)*t.LabelHeight + float64(t.Rows-1)*t.GapY
+	if right > t.PageWidth+0.5 || bottom > t.PageHeight+0.5 {
+		return fmt.Errorf("labels of template %q do not fit on the page", t.Code)
+	}
+	return nil
+}
+
+func (t *LabelTemplate) String() string {
+	return fmt.Sprintf("LabelTemplate{Code: %s, Grid: %dx%d, Label: %gx%g mm}", t.Code, t.Columns, t.Rows, t.LabelWidth, t.LabelHeight)
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "stockify_backend_golang/src/feature/label/model"
+
+type LabelRepository interface {
+	GetSettings() model.LabelSettings
+	SaveSettings(settings model.LabelSettings) error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/label/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.LabelSettings{})
+	if err != nil {
+		log.Fatal("Failed to migrate LabelSettings table: " + err.Error())
+	}
+}
+
+type labelRepository struct{}
+
+func LabelRepositoryImplementation() LabelRepository {
+	return &labelRepository{}
+}
+
+// GetSettings returns the stored settings, or the defaults if none have been
+// saved yet.
+func (r *labelRepository) GetSettings() model.LabelSettings {
+	var settings model.LabelSettings
+	result := db.DB.Order("id").Limit(1).Find(&settings)
+	if result.RowsAffected == 0 {
+		return model.LabelSettings{
+			DefaultTemplate:  model.LabelTemplates[0].Code,
+			DefaultSymbology: model.QR_CODE,
+		}
+	}
+	return settings
+}
+
+func (r *labelRepository) SaveSettings(settings model.LabelSettings) error {
+	existing := r.GetSettings()
+	settings.ID = existing.ID
+	settings.CreatedAt = existing.CreatedAt
+	return db.DB.Save(&settings).Error
+}
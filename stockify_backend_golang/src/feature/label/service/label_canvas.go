@@ -0,0 +1,174 @@
+package service
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"stockify_backend_golang/src/common/pdf"
+	"strings"
+)
+
+// canvas is what labels are drawn on. Coordinates are in millimetres from the
+// top-left corner of the page, text sizes in points.
+type canvas interface {
+	newPage()
+	rect(x, y, w, h float64)
+	outline(x, y, w, h float64)
+	text(x, y float64, size float64, bold bool, s string)
+	textWidth(s string, size float64, bold bool) float64
+	save(outPath string) ([]string, error)
+}
+
+type pdfCanvas struct {
+	doc  *pdf.Document
+	page *pdf.Page
+}
+
+func newPDFCanvas(width, height float64) *pdfCanvas {
+	return &pdfCanvas{doc: pdf.NewDocument(width*pdf.PointsPerMM, height*pdf.PointsPerMM)}
+}
+
+func (c *pdfCanvas) newPage() {
+	c.page = c.doc.AddPage()
+}
+
+func (c *pdfCanvas) rect(x, y, w, h float64) {
+	c.page.Rect(x*pdf.PointsPerMM, y*pdf.PointsPerMM, w*pdf.PointsPerMM, h*pdf.PointsPerMM)
+}
+
+func (c *pdfCanvas) outline(x, y, w, h float64) {
+	c.page.SetStrokeColor(0.7, 0.7, 0.7)
+	c.page.StrokeRect(x*pdf.PointsPerMM, y*pdf.PointsPerMM, w*pdf.PointsPerMM, h*pdf.PointsPerMM, 0.3)
+}
+
+func (c *pdfCanvas) text(x, y float64, size float64, bold bool, s string) {
+	c.page.Text(x*pdf.PointsPerMM, y*pdf.PointsPerMM, pdfFont(bold), size, s)
+}
+
+func (c *pdfCanvas) textWidth(s string, size float64, bold bool) float64 {
+	return pdf.TextWidth(s, pdfFont(bold), size) / pdf.PointsPerMM
+}
+
+func (c *pdfCanvas) save(outPath string) ([]string, error) {
+	return []string{outPath}, c.doc.Save(outPath)
+}
+
+func pdfFont(bold bool) pdf.Font {
+	if bold {
+		return pdf.Bold
+	}
+	return pdf.Regular
+}
+
+// pngDotsPerMM is 300 dpi, the usual resolution of label printers
+const pngDotsPerMM = 300 / 25.4
+
+// pngCanvas renders each page to a grayscale image. Text is drawn with the
+// built-in bitmap font, which covers what asset numbers are made of.
+type pngCanvas struct {
+	width, height int
+	pages         []*image.Gray
+}
+
+func newPNGCanvas(width, height float64) *pngCanvas {
+	return &pngCanvas{width: dots(width), height: dots(height)}
+}
+
+func (c *pngCanvas) newPage() {
+	page := image.NewGray(image.Rect(0, 0, c.width, c.height))
+	for i := range page.Pix {
+		page.Pix[i] = 0xFF
+	}
+	c.pages = append(c.pages, page)
+}
+
+func (c *pngCanvas) rect(x, y, w, h float64) {
+	c.fill(dots(x), dots(y), dots(x+w), dots(y+h), 0)
+}
+
+func (c *pngCanvas) outline(x, y, w, h float64) {
+	x0, y0, x1, y1 := dots(x), dots(y), dots(x+w), dots(y+h)
+	const gray = 0xB3
+	c.fill(x0, y0, x1, y0+1, gray)
+	c.fill(x0, y1-1, x1, y1, gray)
+	c.fill(x0, y0, x0+1, y1, gray)
+	c.fill(x1-1, y0, x1, y1, gray)
+}
+
+func (c *pngCanvas) text(x, y float64, size float64, bold bool, s string) {
+	scale := glyphScale(size)
+	left, bottom := dots(x), dots(y)
+	for _, r := range strings.ToUpper(s) {
+		glyph := glyphFor(r)
+		for row, bits := range glyph {
+			for column := 0; column < glyphWidth; column++ {
+				if bits>>(glyphWidth-1-column)&1 == 0 {
+					continue
+				}
+				x0 := left + column*scale
+				y0 := bottom - (glyphHeight-row)*scale
+				extra := 0
+				if bold {
+					extra = max(1, scale/3)
+				}
+				c.fill(x0, y0, x0+scale+extra, y0+scale, 0)
+			}
+		}
+		left += (glyphWidth + 1) * scale
+	}
+}
+
+func (c *pngCanvas) textWidth(s string, size float64, bold bool) float64 {
+	return float64(len([]rune(s))*(glyphWidth+1)*glyphScale(size)) / pngDotsPerMM
+}
+
+// save writes one PNG per page. With more than one page the files are
+// numbered: labels.png becomes labels-1.png, labels-2.png and so on.
+func (c *pngCanvas) save(outPath string) ([]string, error) {
+	var paths []string
+	ext := filepath.Ext(outPath)
+	for i, page := range c.pages {
+		path := outPath
+		if len(c.pages) > 1 {
+			path = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(outPath, ext), i+1, ext)
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return paths, err
+		}
+		if err := png.Encode(file, page); err != nil {
+			file.Close()
+			return paths, err
+		}
+		if err := file.Close(); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func (c *pngCanvas) fill(x0, y0, x1, y1 int, shade uint8) {
+	page := c.pages[len(c.pages)-1]
+	area := image.Rect(x0, y0, x1, y1).Intersect(page.Bounds())
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			page.SetGray(x, y, color.Gray{Y: shade})
+		}
+	}
+}
+
+func dots(mm float64) int {
+	return int(math.Round(mm * pngDotsPerMM))
+}
+
+// glyphScale is the size of a font pixel in dots for text of the given point
+// size, sized so capitals are as tall as those of the PDF fonts
+func glyphScale(size float64) int {
+	capHeight := size * 0.72 * 25.4 / 72 * pngDotsPerMM
+	return max(1, int(math.Round(capHeight/glyphHeight)))
+}
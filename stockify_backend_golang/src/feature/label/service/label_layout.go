@@ -0,0 +1,141 @@
+package service
+
+import (
+	"fmt"
+	"stockify_backend_golang/src/common/barcode"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/label/model"
+)
+
+// Smallest module sizes in millimetres that still scan reliably once printed
+const (
+	minQRModule      = 0.25
+	minCode128Module = 0.19
+)
+
+const mmPerPoint = 25.4 / 72
+
+// drawLabel draws the label of one item with its top-left corner at (x, y):
+// a QR code with the asset number beside it, or a Code 128 barcode with the
+// asset number below it. Model and serial number are added where they fit.
+func drawLabel(c canvas, x, y float64, template model.LabelTemplate, symbology model.Symbology, payload string, item itemmodel.Item) error {
+	w, h := template.LabelWidth, template.LabelHeight
+	pad := min(2, h*0.08)
+	// Text size follows the label height, within what stays legible
+	size := min(14, max(5, h*0.3))
+
+	switch symbology {
+	case model.QR_CODE:
+		code, err := barcode.EncodeQR([]byte(payload))
+		if err != nil {
+			return fmt.Errorf("label for %s: %w", item.AssetNo, err)
+		}
+		side := min(h-2*pad, w/2)
+		module := side / float64(code.Size+2*barcode.QRQuietZone)
+		if module < minQRModule {
+			return fmt.Errorf("QR code for %s does not fit the label, use a larger template or a shorter URL", item.AssetNo)
+		}
+		left := x + pad + barcode.QRQuietZone*module
+		top := y + (h-side)/2 + barcode.QRQuietZone*module
+		for row := 0; row < code.Size; row++ {
+			for column := 0; column < code.Size; {
+				if !code.Dark(column, row) {
+					column++
+					continue
+				}
+				run := column
+				for run < code.Size && code.Dark(run, row) {
+					run++
+				}
+				c.rect(left+float64(column)*module, top+float64(row)*module, float64(run-column)*module, module)
+				column = run
+			}
+		}
+		textLeft := x + pad + side
+		drawText(c, textLeft, y+pad, w-(textLeft-x)-pad, h-2*pad, size, item, false)
+
+	case model.CODE_128:
+		modules, err := barcode.EncodeCode128(payload)
+		if err != nil {
+			return fmt.Errorf("label for %s: %w", item.AssetNo, err)
+		}
+		module := (w - 2*pad) / float64(len(modules)+2*barcode.Code128QuietZone)
+		if module < minCode128Module {
+			return fmt.Errorf("barcode for %s does not fit the label, use a wider template or QR codes", item.AssetNo)
+		}
+		barHeight := (h - 2*pad) * 0.6
+		left := x + pad + barcode.Code128QuietZone*module
+		for i := 0; i < len(modules); {
+			if !modules[i] {
+				i++
+				continue
+			}
+			run := i
+			for run < len(modules) && modules[run] {
+				run++
+			}
+			c.rect(left+float64(i)*module, y+pad, float64(run-i)*module, barHeight)
+			i = run
+		}
+		textTop := y + pad + barHeight + pad/2
+		drawText(c, x+pad, textTop, w-2*pad, y+h-pad-textTop, min(size, (y+h-pad-textTop)/mmPerPoint/1.2), item, true)
+
+	default:
+		return fmt.Errorf("unknown symbology %q", symbology)
+	}
+	return nil
+}
+
+// drawText writes the asset number in bold and then the model and serial
+// number in smaller type, as many lines as fit the box
+func drawText(c canvas, x, y, width, height, size float64, item itemmodel.Item, centered bool) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	lines := []string{item.AssetNo}
+	if item.ModelNo != "" {
+		lines = append(lines, item.ModelNo)
+	}
+	if item.SerialNo != "" {
+		lines = append(lines, "S/N "+item.SerialNo)
+	}
+
+	baseline := y
+	for i, line := range lines {
+		bold := i == 0
+		lineSize := size
+		if !bold {
+			lineSize = max(4, size*0.7)
+		} else {
+			// The asset number shrinks to fit rather than being cut short
+			for lineSize > 5 && c.textWidth(line, lineSize, true) > width {
+				lineSize -= 0.5
+			}
+		}
+		baseline += lineSize * mmPerPoint * 1.15
+		if baseline > y+height {
+			return
+		}
+		line = truncate(c, line, lineSize, bold, width)
+		if centered {
+			c.text(x+(width-c.textWidth(line, lineSize, bold))/2, baseline, lineSize, bold, line)
+		} else {
+			c.text(x, baseline, lineSize, bold, line)
+		}
+		baseline += lineSize * mmPerPoint * 0.25
+	}
+}
+
+func truncate(c canvas, s string, size float64, bold bool, width float64) string {
+	if c.textWidth(s, size, bold) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if candidate := string(runes) + "..."; c.textWidth(candidate, size, bold) <= width {
+			return candidate
+		}
+	}
+	return ""
+}
@@ -0,0 +1,10 @@
+package service
+
+import "stockify_backend_golang/src/feature/label/model"
+
+type LabelService interface {
+	GetTemplates() []model.LabelTemplate
+	GetSettings() model.LabelSettings
+	SaveSettings(settings model.LabelSettings) error
+	GenerateLabels(request model.LabelRequest, outPath string) ([]string, error)
+}
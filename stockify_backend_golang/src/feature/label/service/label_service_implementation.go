@@ -0,0 +1,148 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/label/model"
+	"stockify_backend_golang/src/feature/label/repository"
+	"strings"
+)
+
+type labelService struct {
+	repo        repository.LabelRepository
+	itemService itemservice.ItemService
+}
+
+func LabelServiceImplementation(repo repository.LabelRepository, itemService itemservice.ItemService) LabelService {
+	return &labelService{repo: repo, itemService: itemService}
+}
+
+func (s *labelService) GetTemplates() []model.LabelTemplate {
+	return model.LabelTemplates
+}
+
+func (s *labelService) GetSettings() model.LabelSettings {
+	return s.repo.GetSettings()
+}
+
+// SaveSettings checks that the defaults name a built-in template and a known
+// symbology, and that a URL template has a placeholder for the item.
+func (s *labelService) SaveSettings(settings model.LabelSettings) error {
+	settings.URLTemplate = strings.TrimSpace(settings.URLTemplate)
+	if settings.URLTemplate != "" && !slices.ContainsFunc(model.URLPlaceholders, func(p string) bool {
+		return strings.Contains(settings.URLTemplate, p)
+	}) {
+		return fmt.Errorf("URL template needs one of %s", strings.Join(model.URLPlaceholders, ", "))
+	}
+	if _, ok := model.FindLabelTemplate(settings.DefaultTemplate); !ok {
+		return fmt.Errorf("unknown label template %q", settings.DefaultTemplate)
+	}
+	if !slices.Contains(model.Symbologies, settings.DefaultSymbology) {
+		return fmt.Errorf("unknown symbology %q", settings.DefaultSymbology)
+	}
+	return s.repo.SaveSettings(settings)
+}
+
+// GenerateLabels lays the labels of the requested items out on sheets and
+// writes them to outPath. It returns the files written, which is more than
+// one for multi-page PNG output.
+func (s *labelService) GenerateLabels(request model.LabelRequest, outPath string) ([]string, error) {
+	if outPath == "" {
+		return nil, errors.New("output path is required")
+	}
+	settings := s.repo.GetSettings()
+
+	template, err := s.resolveTemplate(request, settings)
+	if err != nil {
+		return nil, err
+	}
+	symbology := request.Symbology
+	if symbology == "" {
+		symbology = settings.DefaultSymbology
+	}
+	if !slices.Contains(model.Symbologies, symbology) {
+		return nil, fmt.Errorf("unknown symbology %q", symbology)
+	}
+	if request.Skip < 0 || request.Skip >= template.PerPage() {
+		return nil, fmt.Errorf("skip must be between 0 and %d", template.PerPage()-1)
+	}
+
+	items, err := s.selectItems(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no items to label")
+	}
+
+	var c canvas
+	switch request.Format {
+	case model.PDF, "":
+		c = newPDFCanvas(template.PageWidth, template.PageHeight)
+	case model.PNG:
+		c = newPNGCanvas(template.PageWidth, template.PageHeight)
+	default:
+		return nil, fmt.Errorf("unknown label format %q", request.Format)
+	}
+
+	for i, item := range items {
+		slot := (request.Skip + i) % template.PerPage()
+		if i == 0 || slot == 0 {
+			c.newPage()
+		}
+		x, y := template.Position(slot)
+		if request.Outline {
+			c.outline(x, y, template.LabelWidth, template.LabelHeight)
+		}
+		if err := drawLabel(c, x, y, template, symbology, settings.Payload(item, symbology), item); err != nil {
+			return nil, err
+		}
+	}
+	return c.save(outPath)
+}
+
+func (s *labelService) resolveTemplate(request model.LabelRequest, settings model.LabelSettings) (model.LabelTemplate, error) {
+	if request.CustomTemplate != nil {
+		template := *request.CustomTemplate
+		if template.Code == "" {
+			template.Code = "custom"
+		}
+		return template, template.Validate()
+	}
+	code := request.Template
+	if code == "" {
+		code = settings.DefaultTemplate
+	}
+	template, ok := model.FindLabelTemplate(code)
+	if !ok {
+		return model.LabelTemplate{}, fmt.Errorf("unknown label template %q", code)
+	}
+	return template, nil
+}
+
+// selectItems returns the listed items in the order given, or the items
+// matching the filter
+func (s *labelService) selectItems(request model.LabelRequest) ([]itemmodel.Item, error) {
+	if len(request.ItemIDs) > 0 {
+		items := make([]itemmodel.Item, 0, len(request.ItemIDs))
+		for _, id := range request.ItemIDs {
+			item := s.itemService.GetItemById(id)
+			if item.ID == 0 {
+				return nil, fmt.Errorf("item %d not found", id)
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+	if request.Filter != nil {
+		filter := *request.Filter
+		if filter.SortBy == "" {
+			filter.SortBy = "asset_no"
+		}
+		return s.itemService.GetFilteredItems(filter)
+	}
+	return nil, errors.New("select items by ID or with a filter")
+}
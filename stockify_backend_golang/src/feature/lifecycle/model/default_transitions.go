@@ -0,0 +1,39 @@
+package model
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+)
+
+var disposalFields = []string{"reason", "date"}
+
+// DefaultTransitions seed an empty transition table. The legacy Active and
+// Inactive statuses can move into the new lifecycle but not back.
+var DefaultTransitions = []StatusTransition{
+	{FromStatus: itemmodel.ORDERED, ToStatus: itemmodel.IN_STOCK},
+	{FromStatus: itemmodel.IN_STOCK, ToStatus: itemmodel.DEPLOYED},
+	{FromStatus: itemmodel.IN_STOCK, ToStatus: itemmodel.IN_REPAIR},
+	{FromStatus: itemmodel.IN_STOCK, ToStatus: itemmodel.LOST},
+	{FromStatus: itemmodel.IN_STOCK, ToStatus: itemmodel.RETIRED},
+	{FromStatus: itemmodel.DEPLOYED, ToStatus: itemmodel.IN_STOCK},
+	{FromStatus: itemmodel.DEPLOYED, ToStatus: itemmodel.IN_REPAIR},
+	{FromStatus: itemmodel.DEPLOYED, ToStatus: itemmodel.LOST},
+	{FromStatus: itemmodel.DEPLOYED, ToStatus: itemmodel.RETIRED},
+	{FromStatus: itemmodel.IN_REPAIR, ToStatus: itemmodel.IN_STOCK},
+	{FromStatus: itemmodel.IN_REPAIR, ToStatus: itemmodel.DEPLOYED},
+	{FromStatus: itemmodel.IN_REPAIR, ToStatus: itemmodel.RETIRED},
+	{FromStatus: itemmodel.IN_REPAIR, ToStatus: itemmodel.DISPOSED, RequiredFields: disposalFields},
+	{FromStatus: itemmodel.LOST, ToStatus: itemmodel.IN_STOCK},
+	{FromStatus: itemmodel.LOST, ToStatus: itemmodel.DISPOSED, RequiredFields: disposalFields},
+	{FromStatus: itemmodel.RETIRED, ToStatus: itemmodel.DISPOSED, RequiredFields: disposalFields},
+	{FromStatus: itemmodel.ACTIVE, ToStatus: itemmodel.INACTIVE},
+	{FromStatus: itemmodel.ACTIVE, ToStatus: itemmodel.DEPLOYED},
+	{FromStatus: itemmodel.ACTIVE, ToStatus: itemmodel.IN_REPAIR},
+	{FromStatus: itemmodel.ACTIVE, ToStatus: itemmodel.LOST},
+	{FromStatus: itemmodel.ACTIVE, ToStatus: itemmodel.RETIRED},
+	{FromStatus: itemmodel.ACTIVE, ToStatus: itemmodel.DISPOSED, RequiredFields: disposalFields},
+	{FromStatus: itemmodel.INACTIVE, ToStatus: itemmodel.ACTIVE},
+	{FromStatus: itemmodel.INACTIVE, ToStatus: itemmodel.IN_STOCK},
+	{FromStatus: itemmodel.INACTIVE, ToStatus: itemmodel.IN_REPAIR},
+	{FromStatus: itemmodel.INACTIVE, ToStatus: itemmodel.RETIRED},
+	{FromStatus: itemmodel.INACTIVE, ToStatus: itemmodel.DISPOSED, RequiredFields: disposalFields},
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+)
+
+// StatusHistory records one status change of an item together with the
+// fields supplied for the transition.
+type StatusHistory struct {
+	ID         uint64                `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID     uint64                `gorm:"index" json:"ItemID"`
+	FromStatus itemmodel.AssetStatus `json:"FromStatus"`
+	ToStatus   itemmodel.AssetStatus `json:"ToStatus"`
+	Fields     map[string]string     `gorm:"serializer:json" json:"Fields,omitempty"`
+	Note       string                `json:"Note,omitempty"`
+	ChangedAt  time.Time             `gorm:"index" json:"ChangedAt"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+
+	"gorm.io/gorm"
+)
+
+// StatusTransition allows items to move from FromStatus to ToStatus. Every key
+// in RequiredFields must be supplied with the transition, e.g. "reason" and
+// "date" for a disposal. Keys containing "date" must hold a YYYY-MM-DD date.
+type StatusTransition struct {
+	gorm.Model
+	ID             uint64                `gorm:"primaryKey;autoIncrement" json:"ID"`
+	FromStatus     itemmodel.AssetStatus `gorm:"uniqueIndex:idx_status_transition_from_to" json:"FromStatus"`
+	ToStatus       itemmodel.AssetStatus `gorm:"uniqueIndex:idx_status_transition_from_to" json:"ToStatus"`
+	RequiredFields []string              `gorm:"serializer:json" json:"RequiredFields"`
+}
+
+func (t *StatusTransition) String() string {
+	return fmt.Sprintf("StatusTransition{ID: %d, From: %s, To: %s, RequiredFields: %v}",
+		t.ID, t.FromStatus, t.ToStatus, t.RequiredFields)
+}
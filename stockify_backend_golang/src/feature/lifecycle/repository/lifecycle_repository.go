@@ -0,0 +1,17 @@
+package repository
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/lifecycle/model"
+)
+
+type LifecycleRepository interface {
+	GetAllTransitions() []model.StatusTransition
+	GetTransition(from, to itemmodel.AssetStatus) model.StatusTransition
+	GetTransitionById(id uint64) model.StatusTransition
+	AddTransition(transition model.StatusTransition) error
+	UpdateTransition(transition model.StatusTransition) error
+	DeleteTransitionById(id uint64)
+	AddHistory(history model.StatusHistory) error
+	GetHistoryByItemId(itemId uint64) []model.StatusHistory
+}
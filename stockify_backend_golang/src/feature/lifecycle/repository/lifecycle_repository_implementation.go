@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/lifecycle/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.StatusTransition{}, &model.StatusHistory{})
+	if err != nil {
+		log.Fatal("Failed to migrate lifecycle tables: " + err.Error())
+	}
+	seedDefaultTransitions()
+}
+
+func seedDefaultTransitions() {
+	var count int64
+	db.DB.Unscoped().Model(&model.StatusTransition{}).Count(&count)
+	if count > 0 {
+		return
+	}
+	for _, transition := range model.DefaultTransitions {
+		db.DB.Create(&transition)
+	}
+}
+
+type lifecycleRepository struct{}
+
+func LifecycleRepositoryImplementation() LifecycleRepository {
+	return &lifecycleRepository{}
+}
+
+func (r *lifecycleRepository) GetAllTransitions() []model.StatusTransition {
+	var transitions []model.StatusTransition
+	db.DB.Order("from_status, to_status").Find(&transitions)
+	return transitions
+}
+
+func (r *lifecycleRepository) GetTransition(from, to itemmodel.AssetStatus) model.StatusTransition {
+	var transition model.StatusTransition
+	db.DB.Where("from_status = ? AND to_status = ?", from, to).Limit(1).Find(&transition)
+	return transition
+}
+
+func (r *lifecycleRepository) GetTransitionById(id uint64) model.StatusTransition {
+	var transition model.StatusTransition
+	db.DB.First(&transition, id)
+	return transition
+}
+
+// AddTransition revives a previously deleted transition between the same
+// statuses, since the pair is unique.
+func (r *lifecycleRepository) AddTransition(transition model.StatusTransition) error {
+	var deleted model.StatusTransition
+	db.DB.Unscoped().
+		Where("from_status = ? AND to_status = ? AND deleted_at IS NOT NULL", transition.FromStatus, transition.ToStatus).
+		Limit(1).Find(&deleted)
+	if deleted.ID != 0 {
+		transition.ID = deleted.ID
+		transition.CreatedAt = deleted.CreatedAt
+		return db.DB.Unscoped().Save(&transition).Error
+	}
+	return db.DB.Create(&transition).Error
+}
+
+func (r *lifecycleRepository) UpdateTransition(transition model.StatusTransition) error {
+	return db.DB.Save(&transition).Error
+}
+
+func (r *lifecycleRepository) DeleteTransitionById(id uint64) {
+	db.DB.Delete(&model.StatusTransition{}, id)
+}
+
+func (r *lifecycleRepository) AddHistory(history model.StatusHistory) error {
+	return db.DB.Create(&history).Error
+}
+
+func (r *lifecycleRepository) GetHistoryByItemId(itemId uint64) []model.StatusHistory {
+	var history []model.StatusHistory
+	db.DB.Where("item_id = ?", itemId).Order("changed_at, id").Find(&history)
+	return history
+}
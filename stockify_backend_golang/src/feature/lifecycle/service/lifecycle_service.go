@@ -0,0 +1,17 @@
+package service
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/lifecycle/model"
+)
+
+type LifecycleService interface {
+	GetAllTransitions() []model.StatusTransition
+	AddTransition(transition model.StatusTransition) error
+	UpdateTransition(transition model.StatusTransition) error
+	DeleteTransitionById(id uint64)
+	GetAllowedTransitions(from itemmodel.AssetStatus) []model.StatusTransition
+	CheckTransition(from, to itemmodel.AssetStatus, fields map[string]string) error
+	RecordTransition(history model.StatusHistory) error
+	GetHistoryByItemId(itemId uint64) []model.StatusHistory
+}
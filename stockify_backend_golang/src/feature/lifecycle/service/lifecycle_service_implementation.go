@@ -0,0 +1,113 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/lifecycle/repository"
+	"strings"
+	"time"
+)
+
+type lifecycleService struct {
+	repo repository.LifecycleRepository
+}
+
+func LifecycleServiceImplementation(repo repository.LifecycleRepository) LifecycleService {
+	return &lifecycleService{repo: repo}
+}
+
+func (s *lifecycleService) GetAllTransitions() []model.StatusTransition {
+	return s.repo.GetAllTransitions()
+}
+
+func (s *lifecycleService) AddTransition(transition model.StatusTransition) error {
+	if err := validateTransition(transition); err != nil {
+		return err
+	}
+	if s.repo.GetTransition(transition.FromStatus, transition.ToStatus).ID != 0 {
+		return fmt.Errorf("transition from %s to %s already exists", transition.FromStatus, transition.ToStatus)
+	}
+	return s.repo.AddTransition(transition)
+}
+
+func (s *lifecycleService) UpdateTransition(transition model.StatusTransition) error {
+	if err := validateTransition(transition); err != nil {
+		return err
+	}
+	existing := s.repo.GetTransitionById(transition.ID)
+	if existing.ID == 0 {
+		return errors.New("transition not found")
+	}
+	if other := s.repo.GetTransition(transition.FromStatus, transition.ToStatus); other.ID != 0 && other.ID != transition.ID {
+		return fmt.Errorf("transition from %s to %s already exists", transition.FromStatus, transition.ToStatus)
+	}
+	transition.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateTransition(transition)
+}
+
+func (s *lifecycleService) DeleteTransitionById(id uint64) {
+	s.repo.DeleteTransitionById(id)
+}
+
+func (s *lifecycleService) GetAllowedTransitions(from itemmodel.AssetStatus) []model.StatusTransition {
+	var allowed []model.StatusTransition
+	for _, transition := range s.repo.GetAllTransitions() {
+		if transition.FromStatus == from {
+			allowed = append(allowed, transition)
+		}
+	}
+	return allowed
+}
+
+// CheckTransition returns an error unless an item may move from one status to
+// the other with the given fields. Keeping the same status is always allowed,
+// as is setting the first status of an item that has none.
+func (s *lifecycleService) CheckTransition(from, to itemmodel.AssetStatus, fields map[string]string) error {
+	if from == to || from == "" {
+		return nil
+	}
+	transition := s.repo.GetTransition(from, to)
+	if transition.ID == 0 {
+		return fmt.Errorf("status cannot change from %s to %s", from, to)
+	}
+	for _, field := range transition.RequiredFields {
+		value := strings.TrimSpace(fields[field])
+		if value == "" {
+			return fmt.Errorf("changing status from %s to %s requires %q", from, to, field)
+		}
+		if strings.Contains(strings.ToLower(field), "date") {
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				return fmt.Errorf("%q must be a date (YYYY-MM-DD)", field)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *lifecycleService) RecordTransition(history model.StatusHistory) error {
+	if history.ChangedAt.IsZero() {
+		history.ChangedAt = time.Now()
+	}
+	return s.repo.AddHistory(history)
+}
+
+func (s *lifecycleService) GetHistoryByItemId(itemId uint64) []model.StatusHistory {
+	return s.repo.GetHistoryByItemId(itemId)
+}
+
+func validateTransition(transition model.StatusTransition) error {
+	if strings.TrimSpace(string(transition.FromStatus)) == "" || strings.TrimSpace(string(transition.ToStatus)) == "" {
+		return errors.New("transition needs both a from and a to status")
+	}
+	if transition.FromStatus == transition.ToStatus {
+		return errors.New("transition must change the status")
+	}
+	for _, field := range transition.RequiredFields {
+		if strings.TrimSpace(field) == "" {
+			return errors.New("required field names cannot be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LoanStatus string
+
+const (
+	RESERVED    LoanStatus = "Reserved"
+	CHECKED_OUT LoanStatus = "CheckedOut"
+	RETURNED    LoanStatus = "Returned"
+	CANCELLED   LoanStatus = "Cancelled"
+)
+
+// ActiveLoanStatuses hold the item over the loan's window
+var ActiveLoanStatuses = []LoanStatus{RESERVED, CHECKED_OUT}
+
+// Loan lends an item, such as a projector or camera, to a user from StartAt
+// until EndAt, the due date. It starts as a reservation or, for an item
+// handed over on the spot, checked out. A checked out item that is not back
+// by EndAt is overdue and stays unavailable until checked in.
+type Loan struct {
+	gorm.Model
+	ID           uint64     `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID       uint64     `gorm:"index" json:"ItemID"`
+	UserID       uint64     `gorm:"index" json:"UserID"`
+	Status       LoanStatus `gorm:"index" json:"Status"`
+	StartAt      time.Time  `gorm:"index" json:"StartAt"`
+	EndAt        time.Time  `gorm:"index" json:"EndAt"`
+	CheckedOutAt *time.Time `json:"CheckedOutAt,omitempty"`
+	CheckedInAt  *time.Time `json:"CheckedInAt,omitempty"`
+	Note         string     `json:"Note,omitempty"`
+}
+
+func (l *Loan) String() string {
+	return fmt.Sprintf("Loan{ID: %d, ItemID: %d, UserID: %d, Status: %s, StartAt: %s, EndAt: %s}",
+		l.ID, l.ItemID, l.UserID, l.Status, l.StartAt.Format(time.DateTime), l.EndAt.Format(time.DateTime))
+}
+
+// IsOverdue tells whether the item is still out after its due date
+func (l *Loan) IsOverdue(now time.Time) bool {
+	return l.Status == CHECKED_OUT && now.After(l.EndAt)
+}
@@ -0,0 +1,14 @@
+package model
+
+import "stockify_backend_golang/src/common/event"
+
+const (
+	LOAN_RESERVED    event.Type = "loan.reserved"
+	LOAN_CHECKED_OUT event.Type = "loan.checked_out"
+	LOAN_CHECKED_IN  event.Type = "loan.checked_in"
+	LOAN_CANCELLED   event.Type = "loan.cancelled"
+)
+
+type LoanEvent struct {
+	Loan Loan `json:"loan"`
+}
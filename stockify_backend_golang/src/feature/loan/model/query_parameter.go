@@ -0,0 +1,8 @@
+package model
+
+// LoanQueryParams narrows GetLoans; unset fields match every loan
+type LoanQueryParams struct {
+	ItemID   *uint64
+	UserID   *uint64
+	Statuses []LoanStatus
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/loan/model"
+	"time"
+)
+
+type LoanRepository interface {
+	GetLoanById(id uint64) model.Loan
+	GetLoans(params model.LoanQueryParams) []model.Loan
+	GetConflictingLoans(itemId uint64, start, end, now time.Time, excludeId uint64) []model.Loan
+	GetBusyItemIds(start, end, now time.Time) []uint64
+	GetOverdueLoans(now time.Time) []model.Loan
+	AddLoan(loan *model.Loan) error
+	UpdateLoan(loan model.Loan) error
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/loan/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Loan{})
+	if err != nil {
+		log.Fatal("Failed to migrate Loan table: " + err.Error())
+	}
+}
+
+type loanRepository struct{}
+
+func LoanRepositoryImplementation() LoanRepository {
+	return &loanRepository{}
+}
+
+func (r *loanRepository) GetLoanById(id uint64) model.Loan {
+	var loan model.Loan
+	db.DB.First(&loan, id)
+	return loan
+}
+
+func (r *loanRepository) GetLoans(params model.LoanQueryParams) []model.Loan {
+	query := db.DB.Model(&model.Loan{})
+	if params.ItemID != nil {
+		query = query.Where("item_id = ?", *params.ItemID)
+	}
+	if params.UserID != nil {
+		query = query.Where("user_id = ?", *params.UserID)
+	}
+	if len(params.Statuses) > 0 {
+		query = query.Where("status IN ?", params.Statuses)
+	}
+	var loans []model.Loan
+	query.Order("start_at, id").Find(&loans)
+	return loans
+}
+
+// overlapping selects the active loans holding an item at some point of
+// [start, end). A checked out loan past its due date holds the item until
+// now, when it is still out.
+func overlapping(start, end, now time.Time) *gorm.DB {
+	return db.DB.Model(&model.Loan{}).
+		Where("status IN ?", model.ActiveLoanStatuses).
+		Where("start_at < ?", end).
+		Where("end_at > ? OR (status = ? AND ? > ?)", start, model.CHECKED_OUT, now, start)
+}
+
+func (r *loanRepository) GetConflictingLoans(itemId uint64, start, end, now time.Time, excludeId uint64) []model.Loan {
+	var loans []model.Loan
+	overlapping(start, end, now).Where("item_id = ? AND id <> ?", itemId, excludeId).Order("start_at").Find(&loans)
+	return loans
+}
+
+func (r *loanRepository) GetBusyItemIds(start, end, now time.Time) []uint64 {
+	var ids []uint64
+	overlapping(start, end, now).Distinct().Pluck("item_id", &ids)
+	return ids
+}
+
+func (r *loanRepository) GetOverdueLoans(now time.Time) []model.Loan {
+	var loans []model.Loan
+	db.DB.Where("status = ? AND end_at < ?", model.CHECKED_OUT, now).Order("end_at").Find(&loans)
+	return loans
+}
+
+func (r *loanRepository) AddLoan(loan *model.Loan) error {
+	return db.DB.Create(loan).Error
+}
+
+func (r *loanRepository) UpdateLoan(loan model.Loan) error {
+	return db.DB.Save(&loan).Error
+}
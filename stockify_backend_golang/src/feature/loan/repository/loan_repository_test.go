@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"slices"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/loan/model"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// useMemoryDB points the repository at an empty in-memory database for the
+// length of the test, so the loans written never reach the app's database.
+func useMemoryDB(t *testing.T) {
+	t.Helper()
+	memory, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	// Every connection to :memory: is a database of its own
+	sqlDB, err := memory.DB()
+	if err != nil {
+		t.Fatalf("pool: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err := memory.AutoMigrate(&model.Loan{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	previous := db.DB
+	db.DB = memory
+	t.Cleanup(func() {
+		db.DB = previous
+		sqlDB.Close()
+	})
+}
+
+func TestOverlapping(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, time.June, d, 9, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		loan     model.Loan
+		start    time.Time
+		end      time.Time
+		now      time.Time
+		conflict bool
+	}{
+		{"reservation inside the window", model.Loan{Status: model.RESERVED, StartAt: day(11), EndAt: day(12)}, day(10), day(13), day(1), true},
+		{"reservation covering the window", model.Loan{Status: model.RESERVED, StartAt: day(5), EndAt: day(20)}, day(10), day(13), day(1), true},
+		{"reservation overlapping the start", model.Loan{Status: model.RESERVED, StartAt: day(8), EndAt: day(11)}, day(10), day(13), day(1), true},
+		{"reservation overlapping the end", model.Loan{Status: model.RESERVED, StartAt: day(12), EndAt: day(15)}, day(10), day(13), day(1), true},
+		{"reservation ending as the window starts", model.Loan{Status: model.RESERVED, StartAt: day(8), EndAt: day(10)}, day(10), day(13), day(1), false},
+		{"reservation starting as the window ends", model.Loan{Status: model.RESERVED, StartAt: day(13), EndAt: day(15)}, day(10), day(13), day(1), false},
+		{"reservation before the window", model.Loan{Status: model.RESERVED, StartAt: day(2), EndAt: day(4)}, day(10), day(13), day(1), false},
+		{"checked out within its due date", model.Loan{Status: model.CHECKED_OUT, StartAt: day(8), EndAt: day(11)}, day(10), day(13), day(9), true},
+		{"checked out and due before the window", model.Loan{Status: model.CHECKED_OUT, StartAt: day(2), EndAt: day(4)}, day(10), day(13), day(3), false},
+		{"overdue and still out when the window starts", model.Loan{Status: model.CHECKED_OUT, StartAt: day(2), EndAt: day(4)}, day(10), day(13), day(11), true},
+		{"overdue but the window starts later", model.Loan{Status: model.CHECKED_OUT, StartAt: day(2), EndAt: day(4)}, day(10), day(13), day(6), false},
+		{"overdue loan starting after the window", model.Loan{Status: model.CHECKED_OUT, StartAt: day(14), EndAt: day(15)}, day(10), day(13), day(20), false},
+		{"cancelled reservation", model.Loan{Status: model.CANCELLED, StartAt: day(11), EndAt: day(12)}, day(10), day(13), day(1), false},
+		{"returned loan", model.Loan{Status: model.RETURNED, StartAt: day(2), EndAt: day(4)}, day(10), day(13), day(11), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useMemoryDB(t)
+			repo := LoanRepositoryImplementation()
+			loan := test.loan
+			loan.ItemID = 7
+			if err := repo.AddLoan(&loan); err != nil {
+				t.Fatalf("AddLoan: %v", err)
+			}
+
+			conflicts := repo.GetConflictingLoans(7, test.start, test.end, test.now, 0)
+			if got := len(conflicts) > 0; got != test.conflict {
+				t.Errorf("GetConflictingLoans found %d, want conflict %t", len(conflicts), test.conflict)
+			}
+			if got := slices.Contains(repo.GetBusyItemIds(test.start, test.end, test.now), 7); got != test.conflict {
+				t.Errorf("GetBusyItemIds lists the item: %t, want %t", got, test.conflict)
+			}
+			// A loan never conflicts with itself, which lets it be edited
+			if conflicts := repo.GetConflictingLoans(7, test.start, test.end, test.now, loan.ID); len(conflicts) != 0 {
+				t.Errorf("the loan conflicts with itself")
+			}
+			if conflicts := repo.GetConflictingLoans(8, test.start, test.end, test.now, 0); len(conflicts) != 0 {
+				t.Errorf("another item's loan conflicts")
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/loan/model"
+	"time"
+)
+
+type LoanService interface {
+	GetLoanById(id uint64) model.Loan
+	GetLoans(params model.LoanQueryParams) []model.Loan
+	Reserve(itemId, userId uint64, start, end time.Time, note string) (model.Loan, error)
+	CheckOut(itemId, userId uint64, due time.Time, note string) (model.Loan, error)
+	CheckOutReservation(id uint64, due *time.Time) (model.Loan, error)
+	CheckIn(id uint64) (model.Loan, error)
+	Cancel(id uint64) (model.Loan, error)
+	GetOverdueLoans() []model.Loan
+	GetAvailableItems(deviceType itemmodel.DeviceType, start, end time.Time) ([]itemmodel.Item, error)
+}
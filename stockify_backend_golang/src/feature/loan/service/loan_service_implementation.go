@@ -0,0 +1,216 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"stockify_backend_golang/src/common/event"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/loan/model"
+	"stockify_backend_golang/src/feature/loan/repository"
+	userrepository "stockify_backend_golang/src/feature/user/repository"
+	"strings"
+	"time"
+)
+
+// unlendableStatuses are the item statuses that keep an item out of the pool
+var unlendableStatuses = []itemmodel.AssetStatus{
+	itemmodel.IN_REPAIR, itemmodel.LOST, itemmodel.RETIRED, itemmodel.DISPOSED,
+}
+
+type loanService struct {
+	repo        repository.LoanRepository
+	itemService itemservice.ItemService
+	userRepo    userrepository.UserRepository
+}
+
+func LoanServiceImplementation(
+	repo repository.LoanRepository,
+	itemService itemservice.ItemService,
+	userRepo userrepository.UserRepository,
+) LoanService {
+	return &loanService{repo: repo, itemService: itemService, userRepo: userRepo}
+}
+
+func (s *loanService) GetLoanById(id uint64) model.Loan {
+	return s.repo.GetLoanById(id)
+}
+
+func (s *loanService) GetLoans(params model.LoanQueryParams) []model.Loan {
+	return s.repo.GetLoans(params)
+}
+
+// Reserve books an item for a user from start until end, failing when the
+// item is reserved or out over any part of that window.
+func (s *loanService) Reserve(itemId, userId uint64, start, end time.Time, note string) (model.Loan, error) {
+	loan := model.Loan{
+		ItemID:  itemId,
+		UserID:  userId,
+		Status:  model.RESERVED,
+		StartAt: start.UTC(),
+		EndAt:   end.UTC(),
+		Note:    strings.TrimSpace(note),
+	}
+	if err := s.check(loan, time.Now()); err != nil {
+		return loan, err
+	}
+	if err := s.repo.AddLoan(&loan); err != nil {
+		return loan, err
+	}
+	event.Publish(model.LOAN_RESERVED, model.LoanEvent{Loan: loan})
+	return loan, nil
+}
+
+// CheckOut hands an item over to a user now, due back at due
+func (s *loanService) CheckOut(itemId, userId uint64, due time.Time, note string) (model.Loan, error) {
+	now := time.Now().UTC()
+	loan := model.Loan{
+		ItemID:       itemId,
+		UserID:       userId,
+		Status:       model.CHECKED_OUT,
+		StartAt:      now,
+		EndAt:        due.UTC(),
+		CheckedOutAt: &now,
+		Note:         strings.TrimSpace(note),
+	}
+	if err := s.check(loan, now); err != nil {
+		return loan, err
+	}
+	if err := s.repo.AddLoan(&loan); err != nil {
+		return loan, err
+	}
+	event.Publish(model.LOAN_CHECKED_OUT, model.LoanEvent{Loan: loan})
+	return loan, nil
+}
+
+// CheckOutReservation hands a reserved item over now. The loan keeps the
+// reserved end as due date unless due is given; collecting the item early
+// moves the start of the loan to now.
+func (s *loanService) CheckOutReservation(id uint64, due *time.Time) (model.Loan, error) {
+	loan := s.repo.GetLoanById(id)
+	if loan.ID == 0 {
+		return loan, errors.New("loan not found")
+	}
+	if loan.Status != model.RESERVED {
+		return loan, fmt.Errorf("loan is %s, not reserved", loan.Status)
+	}
+	now := time.Now().UTC()
+	if now.Before(loan.StartAt) {
+		loan.StartAt = now
+	}
+	if due != nil {
+		loan.EndAt = due.UTC()
+	}
+	loan.Status = model.CHECKED_OUT
+	loan.CheckedOutAt = &now
+	if err := s.check(loan, now); err != nil {
+		return s.repo.GetLoanById(id), err
+	}
+	if err := s.repo.UpdateLoan(loan); err != nil {
+		return loan, err
+	}
+	event.Publish(model.LOAN_CHECKED_OUT, model.LoanEvent{Loan: loan})
+	return loan, nil
+}
+
+// CheckIn records a checked out item as returned
+func (s *loanService) CheckIn(id uint64) (model.Loan, error) {
+	loan := s.repo.GetLoanById(id)
+	if loan.ID == 0 {
+		return loan, errors.New("loan not found")
+	}
+	if loan.Status != model.CHECKED_OUT {
+		return loan, fmt.Errorf("loan is %s, not checked out", loan.Status)
+	}
+	now := time.Now().UTC()
+	loan.Status = model.RETURNED
+	loan.CheckedInAt = &now
+	if err := s.repo.UpdateLoan(loan); err != nil {
+		return loan, err
+	}
+	event.Publish(model.LOAN_CHECKED_IN, model.LoanEvent{Loan: loan})
+	return loan, nil
+}
+
+// Cancel drops a reservation, freeing its window
+func (s *loanService) Cancel(id uint64) (model.Loan, error) {
+	loan := s.repo.GetLoanById(id)
+	if loan.ID == 0 {
+		return loan, errors.New("loan not found")
+	}
+	if loan.Status != model.RESERVED {
+		return loan, fmt.Errorf("loan is %s, only reservations can be cancelled", loan.Status)
+	}
+	loan.Status = model.CANCELLED
+	if err := s.repo.UpdateLoan(loan); err != nil {
+		return loan, err
+	}
+	event.Publish(model.LOAN_CANCELLED, model.LoanEvent{Loan: loan})
+	return loan, nil
+}
+
+// GetOverdueLoans returns the checked out loans past their due date, the
+// longest overdue first.
+func (s *loanService) GetOverdueLoans() []model.Loan {
+	return s.repo.GetOverdueLoans(time.Now().UTC())
+}
+
+// GetAvailableItems returns the pool items of a device type that are free
+// from start until end and not out of service. Items assigned to a user are
+// not in the pool.
+func (s *loanService) GetAvailableItems(deviceType itemmodel.DeviceType, start, end time.Time) ([]itemmodel.Item, error) {
+	if !end.After(start) {
+		return nil, errors.New("end must be after start")
+	}
+	items, err := s.itemService.GetFilteredItems(itemmodel.ItemFilterParams{DeviceType: &deviceType, SortBy: "asset_no"})
+	if err != nil {
+		return nil, err
+	}
+	busy := s.repo.GetBusyItemIds(start.UTC(), end.UTC(), time.Now().UTC())
+	available := []itemmodel.Item{}
+	for _, item := range items {
+		if item.AssignedToID == nil && !slices.Contains(busy, item.ID) &&
+			!slices.Contains(unlendableStatuses, item.AssetStatus) {
+			available = append(available, item)
+		}
+	}
+	return available, nil
+}
+
+// check validates a new or changed active loan, including that no other
+// active loan holds the item during its window.
+func (s *loanService) check(loan model.Loan, now time.Time) error {
+	if !loan.EndAt.After(loan.StartAt) {
+		if loan.Status == model.CHECKED_OUT {
+			return errors.New("due date must be after check-out")
+		}
+		return errors.New("end must be after start")
+	}
+	if loan.Status == model.RESERVED && !loan.EndAt.After(now) {
+		return errors.New("reservation window is already over")
+	}
+	item := s.itemService.GetItemById(loan.ItemID)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	if slices.Contains(unlendableStatuses, item.AssetStatus) {
+		return fmt.Errorf("item %s is %s and cannot be lent", item.AssetNo, item.AssetStatus)
+	}
+	if item.AssignedToID != nil {
+		return fmt.Errorf("item %s is assigned to a user and cannot be lent", item.AssetNo)
+	}
+	if s.userRepo.GetUserById(loan.UserID).ID == 0 {
+		return errors.New("user not found")
+	}
+	conflicts := s.repo.GetConflictingLoans(loan.ItemID, loan.StartAt, loan.EndAt, now.UTC(), loan.ID)
+	if len(conflicts) > 0 {
+		conflict := conflicts[0]
+		if conflict.Status == model.CHECKED_OUT {
+			return fmt.Errorf("item %s is checked out until %s", item.AssetNo, conflict.EndAt.Local().Format("2006-01-02 15:04"))
+		}
+		return fmt.Errorf("item %s is reserved from %s to %s", item.AssetNo,
+			conflict.StartAt.Local().Format("2006-01-02 15:04"), conflict.EndAt.Local().Format("2006-01-02 15:04"))
+	}
+	return nil
+}
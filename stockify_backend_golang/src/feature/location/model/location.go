@@ -0,0 +1,20 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Location is a node of the site > building > floor > room hierarchy.
+type Location struct {
+	gorm.Model
+	ID       uint64       `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name     string       `json:"Name"`
+	Kind     LocationKind `json:"Kind"`
+	ParentID *uint64      `gorm:"index" json:"ParentID,omitempty"`
+}
+
+func (l *Location) String() string {
+	return fmt.Sprintf("Location{ID: %d, Name: %s, Kind: %s}", l.ID, l.Name, l.Kind)
+}
@@ -0,0 +1,24 @@
+package model
+
+type LocationKind string
+
+const (
+	SITE     LocationKind = "Site"
+	BUILDING LocationKind = "Building"
+	FLOOR    LocationKind = "Floor"
+	ROOM     LocationKind = "Room"
+)
+
+// LocationKinds lists the hierarchy from the top level down
+var LocationKinds = []LocationKind{SITE, BUILDING, FLOOR, ROOM}
+
+// Level is the depth of the kind in the hierarchy, 0 for a site, or -1 for an
+// unknown kind.
+func (k LocationKind) Level() int {
+	for i, kind := range LocationKinds {
+		if kind == k {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,14 @@
+package model
+
+// LocationNode is a location with its children and inventory counts. The
+// direct counts cover the location itself, the total counts include every
+// location below it.
+type LocationNode struct {
+	Location
+	Path           string         `json:"Path"`
+	ItemCount      int64          `json:"ItemCount"`
+	TotalItemCount int64          `json:"TotalItemCount"`
+	UserCount      int64          `json:"UserCount"`
+	TotalUserCount int64          `json:"TotalUserCount"`
+	Children       []LocationNode `json:"Children"`
+}
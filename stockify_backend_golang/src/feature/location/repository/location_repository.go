@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/location/model"
+)
+
+type LocationRepository interface {
+	GetAllLocations() []model.Location
+	GetLocationById(id uint64) model.Location
+	AddLocation(location model.Location) error
+	UpdateLocation(location model.Location) error
+	DeleteLocationById(id uint64)
+	CountChildren(id uint64) int64
+	CountReferences(id uint64) int64
+	CountItemsByLocation() (map[uint64]int64, error)
+	CountUsersByLocation() (map[uint64]int64, error)
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/location/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Location{})
+	if err != nil {
+		log.Fatal("Failed to migrate Location table: " + err.Error())
+	}
+}
+
+// SubtreeQuery selects the id of a location and of every location below it;
+// the single argument is the root location id.
+const SubtreeQuery = `WITH RECURSIVE subtree(id) AS (
+	SELECT ?
+	UNION ALL
+	SELECT locations.id FROM locations JOIN subtree ON locations.parent_id = subtree.id
+	WHERE locations.deleted_at IS NULL
+) SELECT id FROM subtree`
+
+type locationRepository struct{}
+
+func LocationRepositoryImplementation() LocationRepository {
+	return &locationRepository{}
+}
+
+func (r *locationRepository) GetAllLocations() []model.Location {
+	var locations []model.Location
+	db.DB.Order("name").Find(&locations)
+	return locations
+}
+
+func (r *locationRepository) GetLocationById(id uint64) model.Location {
+	var location model.Location
+	db.DB.First(&location, id)
+	return location
+}
+
+func (r *locationRepository) AddLocation(location model.Location) error {
+	return db.DB.Create(&location).Error
+}
+
+func (r *locationRepository) UpdateLocation(location model.Location) error {
+	return db.DB.Save(&location).Error
+}
+
+func (r *locationRepository) DeleteLocationById(id uint64) {
+	db.DB.Delete(&model.Location{}, id)
+}
+
+func (r *locationRepository) CountChildren(id uint64) int64 {
+	var count int64
+	db.DB.Model(&model.Location{}).Where("parent_id = ?", id).Count(&count)
+	return count
+}
+
+// CountReferences counts the items and users placed directly at the location
+func (r *locationRepository) CountReferences(id uint64) int64 {
+	var items, users int64
+	db.DB.Table("items").Where("location_id = ? AND deleted_at IS NULL", id).Count(&items)
+	db.DB.Table("users").Where("location_id = ? AND deleted_at IS NULL", id).Count(&users)
+	return items + users
+}
+
+func (r *locationRepository) CountItemsByLocation() (map[uint64]int64, error) {
+	return countByLocation("items")
+}
+
+func (r *locationRepository) CountUsersByLocation() (map[uint64]int64, error) {
+	return countByLocation("users")
+}
+
+func countByLocation(table string) (map[uint64]int64, error) {
+	var rows []struct {
+		LocationID uint64
+		Count      int64
+	}
+	err := db.DB.Table(table).
+		Select("location_id, COUNT(*) AS count").
+		Where("location_id IS NOT NULL AND deleted_at IS NULL").
+		Group("location_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[uint64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.LocationID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/location/model"
+)
+
+type LocationService interface {
+	GetAllLocations() []model.Location
+	GetLocationById(id uint64) model.Location
+	GetLocationTree() ([]model.LocationNode, error)
+	AddLocation(location model.Location) error
+	UpdateLocation(location model.Location) error
+	DeleteLocationById(id uint64) error
+	SetItemLocation(itemId uint64, locationId *uint64) error
+	SetUserLocation(userId uint64, locationId *uint64) error
+}
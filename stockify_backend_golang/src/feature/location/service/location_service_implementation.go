@@ -0,0 +1,181 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/location/model"
+	"stockify_backend_golang/src/feature/location/repository"
+	userservice "stockify_backend_golang/src/feature/user/service"
+	"strings"
+)
+
+type locationService struct {
+	repo        repository.LocationRepository
+	itemService itemservice.ItemService
+	userService userservice.UserService
+}
+
+func LocationServiceImplementation(
+	repo repository.LocationRepository,
+	itemService itemservice.ItemService,
+	userService userservice.UserService,
+) LocationService {
+	return &locationService{repo: repo, itemService: itemService, userService: userService}
+}
+
+func (s *locationService) GetAllLocations() []model.Location {
+	return s.repo.GetAllLocations()
+}
+
+func (s *locationService) GetLocationById(id uint64) model.Location {
+	return s.repo.GetLocationById(id)
+}
+
+// GetLocationTree returns the sites with everything below them, each node
+// carrying its own item and user counts and the totals of its subtree.
+func (s *locationService) GetLocationTree() ([]model.LocationNode, error) {
+	itemCounts, err := s.repo.CountItemsByLocation()
+	if err != nil {
+		return nil, err
+	}
+	userCounts, err := s.repo.CountUsersByLocation()
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uint64][]model.Location)
+	var roots []model.Location
+	for _, location := range s.repo.GetAllLocations() {
+		if location.ParentID == nil {
+			roots = append(roots, location)
+		} else {
+			children[*location.ParentID] = append(children[*location.ParentID], location)
+		}
+	}
+	var build func(location model.Location, parentPath string) model.LocationNode
+	build = func(location model.Location, parentPath string) model.LocationNode {
+		node := model.LocationNode{
+			Location:  location,
+			Path:      location.Name,
+			ItemCount: itemCounts[location.ID],
+			UserCount: userCounts[location.ID],
+			Children:  []model.LocationNode{},
+		}
+		if parentPath != "" {
+			node.Path = parentPath + " / " + location.Name
+		}
+		node.TotalItemCount = node.ItemCount
+		node.TotalUserCount = node.UserCount
+		for _, child := range children[location.ID] {
+			childNode := build(child, node.Path)
+			node.TotalItemCount += childNode.TotalItemCount
+			node.TotalUserCount += childNode.TotalUserCount
+			node.Children = append(node.Children, childNode)
+		}
+		return node
+	}
+	tree := []model.LocationNode{}
+	for _, root := range roots {
+		tree = append(tree, build(root, ""))
+	}
+	return tree, nil
+}
+
+func (s *locationService) AddLocation(location model.Location) error {
+	if err := s.validateLocation(location); err != nil {
+		return err
+	}
+	return s.repo.AddLocation(location)
+}
+
+func (s *locationService) UpdateLocation(location model.Location) error {
+	existing := s.repo.GetLocationById(location.ID)
+	if existing.ID == 0 {
+		return errors.New("location not found")
+	}
+	if err := s.validateLocation(location); err != nil {
+		return err
+	}
+	// Children were validated against the current kind
+	if location.Kind != existing.Kind && s.repo.CountChildren(location.ID) > 0 {
+		return fmt.Errorf("cannot change the kind of %s while it contains other locations", existing.Name)
+	}
+	location.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateLocation(location)
+}
+
+func (s *locationService) DeleteLocationById(id uint64) error {
+	if count := s.repo.CountChildren(id); count > 0 {
+		return fmt.Errorf("location contains %d other location(s)", count)
+	}
+	if count := s.repo.CountReferences(id); count > 0 {
+		return fmt.Errorf("location is used by %d item(s) or user(s)", count)
+	}
+	s.repo.DeleteLocationById(id)
+	return nil
+}
+
+// SetItemLocation moves the item through the item service, which publishes
+// the update like any other edit.
+func (s *locationService) SetItemLocation(itemId uint64, locationId *uint64) error {
+	item := s.itemService.GetItemById(itemId)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	if err := s.checkExists(locationId); err != nil {
+		return err
+	}
+	item.LocationID = locationId
+	return s.itemService.UpdateItem(item)
+}
+
+func (s *locationService) SetUserLocation(userId uint64, locationId *uint64) error {
+	user := s.userService.GetUserById(userId)
+	if user.ID == 0 {
+		return errors.New("user not found")
+	}
+	if err := s.checkExists(locationId); err != nil {
+		return err
+	}
+	user.LocationID = locationId
+	s.userService.UpdateUser(user)
+	return nil
+}
+
+// validateLocation checks that a site stands alone and every other location
+// sits directly below the level above it, e.g. a room on a floor.
+func (s *locationService) validateLocation(location model.Location) error {
+	if strings.TrimSpace(location.Name) == "" {
+		return errors.New("location name is required")
+	}
+	level := location.Kind.Level()
+	if level < 0 {
+		return fmt.Errorf("unknown location kind %q", location.Kind)
+	}
+	if level == 0 {
+		if location.ParentID != nil {
+			return errors.New("a site cannot have a parent location")
+		}
+		return nil
+	}
+	if location.ParentID == nil {
+		return fmt.Errorf("a %s needs a parent %s", strings.ToLower(string(location.Kind)),
+			strings.ToLower(string(model.LocationKinds[level-1])))
+	}
+	parent := s.repo.GetLocationById(*location.ParentID)
+	if parent.ID == 0 {
+		return errors.New("parent location not found")
+	}
+	if parent.Kind.Level() != level-1 {
+		return fmt.Errorf("a %s must be placed in a %s, not a %s", strings.ToLower(string(location.Kind)),
+			strings.ToLower(string(model.LocationKinds[level-1])), strings.ToLower(string(parent.Kind)))
+	}
+	return nil
+}
+
+func (s *locationService) checkExists(locationId *uint64) error {
+	if locationId != nil && s.repo.GetLocationById(*locationId).ID == 0 {
+		return errors.New("location not found")
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package model
+
+type MaintenanceKind string
+
+const (
+	REPAIR     MaintenanceKind = "repair"
+	PREVENTIVE MaintenanceKind = "preventive"
+)
+
+type MaintenanceOutcome string
+
+const (
+	REPAIRED     MaintenanceOutcome = "repaired"
+	REPLACED     MaintenanceOutcome = "replaced"
+	NO_FAULT     MaintenanceOutcome = "no_fault"
+	UNREPAIRABLE MaintenanceOutcome = "unrepairable"
+	DONE         MaintenanceOutcome = "done"
+)
+
+var MaintenanceOutcomes = []MaintenanceOutcome{REPAIRED, REPLACED, NO_FAULT, UNREPAIRABLE, DONE}
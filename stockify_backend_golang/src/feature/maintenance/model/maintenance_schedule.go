@@ -0,0 +1,54 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MaintenanceSchedule asks for preventive maintenance every IntervalDays,
+// either for one item or for all items of a device type.
+type MaintenanceSchedule struct {
+	gorm.Model
+	ID           uint64  `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID       *uint64 `gorm:"index" json:"ItemID,omitempty"`
+	DeviceTypeID *uint64 `gorm:"index" json:"DeviceTypeID,omitempty"`
+	Description  string  `json:"Description"`
+	IntervalDays int     `json:"IntervalDays"`
+	Enabled      bool    `json:"Enabled"`
+}
+
+func (s *MaintenanceSchedule) String() string {
+	return fmt.Sprintf("MaintenanceSchedule{ID: %d, Description: %s, IntervalDays: %d, Enabled: %t}",
+		s.ID, s.Description, s.IntervalDays, s.Enabled)
+}
+
+// MaintainableItem is what the overdue check needs to know about an item.
+// InServiceAt is its received date, or when it was added if unknown.
+type MaintainableItem struct {
+	ItemID       uint64
+	AssetNo      string
+	DeviceTypeID *uint64
+	InServiceAt  time.Time
+}
+
+// CompletedMaintenance is when an item last had a schedule's maintenance done
+type CompletedMaintenance struct {
+	ItemID     uint64
+	ScheduleID uint64
+	DoneAt     time.Time
+}
+
+// OverdueMaintenance is a schedule an item has not kept. LastDoneAt is nil
+// when it was never done, in which case the interval runs from the item's
+// received date.
+type OverdueMaintenance struct {
+	ItemID      uint64     `json:"ItemID"`
+	AssetNo     string     `json:"AssetNo"`
+	ScheduleID  uint64     `json:"ScheduleID"`
+	Description string     `json:"Description"`
+	LastDoneAt  *time.Time `json:"LastDoneAt,omitempty"`
+	DueAt       time.Time  `json:"DueAt"`
+	DaysOverdue int        `json:"DaysOverdue"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MaintenanceTicket records an item going out for repair or preventive
+// maintenance. It is open until DateBack is set. StatusBefore is the status
+// the item had before a repair moved it to In Repair, and is restored when the
+// ticket closes.
+type MaintenanceTicket struct {
+	gorm.Model
+	ID           uint64                `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID       uint64                `gorm:"index" json:"ItemID"`
+	Kind         MaintenanceKind       `json:"Kind"`
+	ScheduleID   *uint64               `gorm:"index" json:"ScheduleID,omitempty"`
+	Description  string                `json:"Description"`
+	DateOut      time.Time             `json:"DateOut"`
+	DateBack     *time.Time            `gorm:"index" json:"DateBack,omitempty"`
+	VendorID     *uint64               `gorm:"index" json:"VendorID,omitempty"`
+	Cost         *float64              `json:"Cost,omitempty"`
+	Currency     *string               `json:"Currency,omitempty"`
+	Outcome      *MaintenanceOutcome   `json:"Outcome,omitempty"`
+	StatusBefore itemmodel.AssetStatus `json:"StatusBefore,omitempty"`
+}
+
+func (t *MaintenanceTicket) String() string {
+	return fmt.Sprintf("MaintenanceTicket{ID: %d, ItemID: %d, Kind: %s, DateOut: %s}",
+		t.ID, t.ItemID, t.Kind, t.DateOut.Format(time.DateOnly))
+}
+
+// TicketClosure is what is known when an item comes back
+type TicketClosure struct {
+	DateBack time.Time          `json:"DateBack"`
+	Outcome  MaintenanceOutcome `json:"Outcome"`
+	Cost     *float64           `json:"Cost"`
+	Currency string             `json:"Currency"`
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/maintenance/model"
+)
+
+type MaintenanceRepository interface {
+	GetTicketById(id uint64) model.MaintenanceTicket
+	GetTicketsByItemId(itemId uint64) []model.MaintenanceTicket
+	GetOpenTickets() []model.MaintenanceTicket
+	AddTicket(ticket *model.MaintenanceTicket, statusChange *lifecyclemodel.StatusHistory) error
+	UpdateTicket(ticket model.MaintenanceTicket) error
+	DeleteTicketById(id uint64, statusChange *lifecyclemodel.StatusHistory) error
+
+	GetAllSchedules() []model.MaintenanceSchedule
+	GetScheduleById(id uint64) model.MaintenanceSchedule
+	AddSchedule(schedule model.MaintenanceSchedule) error
+	UpdateSchedule(schedule model.MaintenanceSchedule) error
+	DeleteScheduleById(id uint64)
+
+	GetMaintainableItems() ([]model.MaintainableItem, error)
+	GetCompletedMaintenance() ([]model.CompletedMaintenance, error)
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/maintenance/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.MaintenanceTicket{}, &model.MaintenanceSchedule{})
+	if err != nil {
+		log.Fatal("Failed to migrate Maintenance tables: " + err.Error())
+	}
+}
+
+type maintenanceRepository struct{}
+
+func MaintenanceRepositoryImplementation() MaintenanceRepository {
+	return &maintenanceRepository{}
+}
+
+func (r *maintenanceRepository) GetTicketById(id uint64) model.MaintenanceTicket {
+	var ticket model.MaintenanceTicket
+	db.DB.First(&ticket, id)
+	return ticket
+}
+
+func (r *maintenanceRepository) GetTicketsByItemId(itemId uint64) []model.MaintenanceTicket {
+	var tickets []model.MaintenanceTicket
+	db.DB.Where("item_id = ?", itemId).Order("date_out DESC").Find(&tickets)
+	return tickets
+}
+
+func (r *maintenanceRepository) GetOpenTickets() []model.MaintenanceTicket {
+	var tickets []model.MaintenanceTicket
+	db.DB.Where("date_back IS NULL").Order("date_out").Find(&tickets)
+	return tickets
+}
+
+// AddTicket stores the ticket together with the status change of its item,
+// if any, in one transaction.
+func (r *maintenanceRepository) AddTicket(ticket *model.MaintenanceTicket, statusChange *lifecyclemodel.StatusHistory) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := changeItemStatus(tx, statusChange); err != nil {
+			return err
+		}
+		return tx.Create(ticket).Error
+	})
+}
+
+func (r *maintenanceRepository) UpdateTicket(ticket model.MaintenanceTicket) error {
+	return db.DB.Save(&ticket).Error
+}
+
+// DeleteTicketById deletes the ticket together with the status change of its
+// item, if any, in one transaction.
+func (r *maintenanceRepository) DeleteTicketById(id uint64, statusChange *lifecyclemodel.StatusHistory) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := changeItemStatus(tx, statusChange); err != nil {
+			return err
+		}
+		return tx.Delete(&model.MaintenanceTicket{}, id).Error
+	})
+}
+
+// changeItemStatus moves the item to the new status of the history entry and
+// records the entry.
+func changeItemStatus(tx *gorm.DB, statusChange *lifecyclemodel.StatusHistory) error {
+	if statusChange == nil {
+		return nil
+	}
+	err := tx.Model(&itemmodel.Item{}).Where("id = ?", statusChange.ItemID).
+		Update("asset_status", statusChange.ToStatus).Error
+	if err != nil {
+		return err
+	}
+	return tx.Create(statusChange).Error
+}
+
+func (r *maintenanceRepository) GetAllSchedules() []model.MaintenanceSchedule {
+	var schedules []model.MaintenanceSchedule
+	db.DB.Order("id").Find(&schedules)
+	return schedules
+}
+
+func (r *maintenanceRepository) GetScheduleById(id uint64) model.MaintenanceSchedule {
+	var schedule model.MaintenanceSchedule
+	db.DB.First(&schedule, id)
+	return schedule
+}
+
+func (r *maintenanceRepository) AddSchedule(schedule model.MaintenanceSchedule) error {
+	return db.DB.Create(&schedule).Error
+}
+
+func (r *maintenanceRepository) UpdateSchedule(schedule model.MaintenanceSchedule) error {
+	return db.DB.Save(&schedule).Error
+}
+
+func (r *maintenanceRepository) DeleteScheduleById(id uint64) {
+	db.DB.Delete(&model.MaintenanceSchedule{}, id)
+}
+
+// GetMaintainableItems returns the items still in use, leaving out those that
+// are retired, disposed or lost.
+func (r *maintenanceRepository) GetMaintainableItems() ([]model.MaintainableItem, error) {
+	var rows []struct {
+		ID           uint64
+		AssetNo      string
+		DeviceTypeID *uint64
+		ReceivedDate *time.Time
+		CreatedAt    time.Time
+	}
+	err := db.DB.Model(&itemmodel.Item{}).
+		Select("id, asset_no, device_type_id, received_date, created_at").
+		Where("asset_status NOT IN ?", []itemmodel.AssetStatus{itemmodel.RETIRED, itemmodel.DISPOSED, itemmodel.LOST}).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.MaintainableItem, 0, len(rows))
+	for _, row := range rows {
+		inService := row.CreatedAt
+		if row.ReceivedDate != nil {
+			inService = *row.ReceivedDate
+		}
+		items = append(items, model.MaintainableItem{
+			ItemID:       row.ID,
+			AssetNo:      row.AssetNo,
+			DeviceTypeID: row.DeviceTypeID,
+			InServiceAt:  inService,
+		})
+	}
+	return items, nil
+}
+
+// GetCompletedMaintenance returns the closed preventive tickets that fulfilled
+// a schedule.
+func (r *maintenanceRepository) GetCompletedMaintenance() ([]model.CompletedMaintenance, error) {
+	var tickets []model.MaintenanceTicket
+	err := db.DB.Select("item_id, schedule_id, date_back").
+		Where("kind = ? AND schedule_id IS NOT NULL AND date_back IS NOT NULL", model.PREVENTIVE).
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+	completed := make([]model.CompletedMaintenance, 0, len(tickets))
+	for _, ticket := range tickets {
+		completed = append(completed, model.CompletedMaintenance{
+			ItemID:     ticket.ItemID,
+			ScheduleID: *ticket.ScheduleID,
+			DoneAt:     *ticket.DateBack,
+		})
+	}
+	return completed, nil
+}
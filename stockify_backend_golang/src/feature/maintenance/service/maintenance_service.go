@@ -0,0 +1,23 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/maintenance/model"
+	"time"
+)
+
+type MaintenanceService interface {
+	GetTicketById(id uint64) model.MaintenanceTicket
+	GetTicketsByItemId(itemId uint64) []model.MaintenanceTicket
+	GetOpenTickets() []model.MaintenanceTicket
+	OpenTicket(ticket model.MaintenanceTicket) error
+	UpdateTicket(ticket model.MaintenanceTicket) error
+	CloseTicket(id uint64, closure model.TicketClosure) error
+	DeleteTicketById(id uint64) error
+
+	GetAllSchedules() []model.MaintenanceSchedule
+	AddSchedule(schedule model.MaintenanceSchedule) error
+	UpdateSchedule(schedule model.MaintenanceSchedule) error
+	DeleteScheduleById(id uint64)
+
+	GetOverdueMaintenance(now time.Time) ([]model.OverdueMaintenance, error)
+}
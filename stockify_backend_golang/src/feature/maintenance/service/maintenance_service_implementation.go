@@ -0,0 +1,265 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"stockify_backend_golang/src/common/event"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	lifecycleservice "stockify_backend_golang/src/feature/lifecycle/service"
+	"stockify_backend_golang/src/feature/maintenance/model"
+	"stockify_backend_golang/src/feature/maintenance/repository"
+	"strings"
+	"time"
+)
+
+type maintenanceService struct {
+	repo             repository.MaintenanceRepository
+	itemService      itemservice.ItemService
+	lifecycleService lifecycleservice.LifecycleService
+}
+
+func MaintenanceServiceImplementation(
+	repo repository.MaintenanceRepository,
+	itemService itemservice.ItemService,
+	lifecycleService lifecycleservice.LifecycleService,
+) MaintenanceService {
+	return &maintenanceService{repo: repo, itemService: itemService, lifecycleService: lifecycleService}
+}
+
+func (s *maintenanceService) GetTicketById(id uint64) model.MaintenanceTicket {
+	return s.repo.GetTicketById(id)
+}
+
+func (s *maintenanceService) GetTicketsByItemId(itemId uint64) []model.MaintenanceTicket {
+	return s.repo.GetTicketsByItemId(itemId)
+}
+
+func (s *maintenanceService) GetOpenTickets() []model.MaintenanceTicket {
+	return s.repo.GetOpenTickets()
+}
+
+// OpenTicket records an item going out. A repair moves the item to In Repair,
+// subject to the lifecycle rules, and remembers the status it came from. The
+// ticket and the status change are saved together or not at all.
+func (s *maintenanceService) OpenTicket(ticket model.MaintenanceTicket) error {
+	item := s.itemService.GetItemById(ticket.ItemID)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	if err := s.validateTicket(&ticket); err != nil {
+		return err
+	}
+	if ticket.DateOut.IsZero() {
+		ticket.DateOut = time.Now()
+	}
+	ticket.DateBack = nil
+	ticket.Outcome = nil
+	ticket.StatusBefore = ""
+
+	var statusChange *lifecyclemodel.StatusHistory
+	if ticket.Kind == model.REPAIR && item.AssetStatus != itemmodel.IN_REPAIR {
+		if err := s.lifecycleService.CheckTransition(item.AssetStatus, itemmodel.IN_REPAIR, nil); err != nil {
+			return err
+		}
+		statusChange = newStatusChange(item, itemmodel.IN_REPAIR, "Repair: "+ticket.Description)
+		ticket.StatusBefore = item.AssetStatus
+	}
+	if err := s.repo.AddTicket(&ticket, statusChange); err != nil {
+		return err
+	}
+	s.publishStatusChange(item, statusChange)
+	return nil
+}
+
+// UpdateTicket edits the details of a ticket. Opening and closing, and the
+// status changes that come with them, go through OpenTicket and CloseTicket.
+func (s *maintenanceService) UpdateTicket(ticket model.MaintenanceTicket) error {
+	existing := s.repo.GetTicketById(ticket.ID)
+	if existing.ID == 0 {
+		return errors.New("maintenance ticket not found")
+	}
+	if err := s.validateTicket(&ticket); err != nil {
+		return err
+	}
+	existing.Kind = ticket.Kind
+	existing.ScheduleID = ticket.ScheduleID
+	existing.Description = ticket.Description
+	existing.VendorID = ticket.VendorID
+	existing.Cost = ticket.Cost
+	existing.Currency = ticket.Currency
+	if !ticket.DateOut.IsZero() {
+		existing.DateOut = ticket.DateOut
+	}
+	if existing.DateBack != nil && ticket.DateBack != nil {
+		existing.DateBack = ticket.DateBack
+	}
+	return s.repo.UpdateTicket(existing)
+}
+
+// CloseTicket records an item coming back. After a repair the item returns
+// to the status it had before, unless it could not be repaired or its status
+// was changed in the meantime.
+func (s *maintenanceService) CloseTicket(id uint64, closure model.TicketClosure) error {
+	ticket := s.repo.GetTicketById(id)
+	if ticket.ID == 0 {
+		return errors.New("maintenance ticket not found")
+	}
+	if ticket.DateBack != nil {
+		return errors.New("maintenance ticket is already closed")
+	}
+	if !slices.Contains(model.MaintenanceOutcomes, closure.Outcome) {
+		return fmt.Errorf("unknown outcome %q", closure.Outcome)
+	}
+	if closure.DateBack.IsZero() {
+		closure.DateBack = time.Now()
+	}
+	if closure.DateBack.Before(ticket.DateOut) {
+		return errors.New("date back cannot be before date out")
+	}
+	if closure.Cost != nil && *closure.Cost < 0 {
+		return errors.New("cost cannot be negative")
+	}
+
+	if ticket.Kind == model.REPAIR && ticket.StatusBefore != "" && closure.Outcome != model.UNREPAIRABLE {
+		item := s.itemService.GetItemById(ticket.ItemID)
+		if item.ID != 0 && item.AssetStatus == itemmodel.IN_REPAIR {
+			note := fmt.Sprintf("Back from repair: %s", closure.Outcome)
+			if err := s.itemService.TransitionStatus(item.ID, ticket.StatusBefore, nil, note); err != nil {
+				return err
+			}
+		}
+	}
+
+	ticket.DateBack = &closure.DateBack
+	ticket.Outcome = &closure.Outcome
+	if closure.Cost != nil {
+		ticket.Cost = closure.Cost
+	}
+	if currency := strings.ToUpper(strings.TrimSpace(closure.Currency)); currency != "" {
+		ticket.Currency = &currency
+	}
+	return s.repo.UpdateTicket(ticket)
+}
+
+// DeleteTicketById deletes a ticket. Deleting an open repair puts the item
+// back to the status it had before, as closing it would.
+func (s *maintenanceService) DeleteTicketById(id uint64) error {
+	ticket := s.repo.GetTicketById(id)
+	if ticket.ID == 0 {
+		return errors.New("maintenance ticket not found")
+	}
+	var item itemmodel.Item
+	var statusChange *lifecyclemodel.StatusHistory
+	if ticket.Kind == model.REPAIR && ticket.DateBack == nil && ticket.StatusBefore != "" {
+		item = s.itemService.GetItemById(ticket.ItemID)
+		// Undoes the move to In Repair, so the lifecycle rules are not asked again
+		if item.ID != 0 && item.AssetStatus == itemmodel.IN_REPAIR {
+			statusChange = newStatusChange(item, ticket.StatusBefore, "Repair ticket deleted")
+		}
+	}
+	if err := s.repo.DeleteTicketById(id, statusChange); err != nil {
+		return err
+	}
+	s.publishStatusChange(item, statusChange)
+	return nil
+}
+
+// newStatusChange describes moving the item to a status for its status history
+func newStatusChange(item itemmodel.Item, to itemmodel.AssetStatus, note string) *lifecyclemodel.StatusHistory {
+	return &lifecyclemodel.StatusHistory{
+		ItemID:     item.ID,
+		FromStatus: item.AssetStatus,
+		ToStatus:   to,
+		Note:       note,
+		ChangedAt:  time.Now(),
+	}
+}
+
+// publishStatusChange announces a status change saved with a ticket, as the
+// item service does for its own updates.
+func (s *maintenanceService) publishStatusChange(previous itemmodel.Item, statusChange *lifecyclemodel.StatusHistory) {
+	if statusChange == nil {
+		return
+	}
+	updated := s.itemService.GetItemById(previous.ID)
+	event.Publish(itemmodel.ITEM_UPDATED, itemmodel.ItemEvent{Item: updated, Previous: &previous})
+}
+
+func (s *maintenanceService) GetAllSchedules() []model.MaintenanceSchedule {
+	return s.repo.GetAllSchedules()
+}
+
+func (s *maintenanceService) AddSchedule(schedule model.MaintenanceSchedule) error {
+	if err := validateSchedule(schedule); err != nil {
+		return err
+	}
+	return s.repo.AddSchedule(schedule)
+}
+
+func (s *maintenanceService) UpdateSchedule(schedule model.MaintenanceSchedule) error {
+	existing := s.repo.GetScheduleById(schedule.ID)
+	if existing.ID == 0 {
+		return errors.New("maintenance schedule not found")
+	}
+	if err := validateSchedule(schedule); err != nil {
+		return err
+	}
+	schedule.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateSchedule(schedule)
+}
+
+func (s *maintenanceService) DeleteScheduleById(id uint64) {
+	s.repo.DeleteScheduleById(id)
+}
+
+func (s *maintenanceService) GetOverdueMaintenance(now time.Time) ([]model.OverdueMaintenance, error) {
+	items, err := s.repo.GetMaintainableItems()
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.repo.GetCompletedMaintenance()
+	if err != nil {
+		return nil, err
+	}
+	return FindOverdue(items, s.repo.GetAllSchedules(), completed, now), nil
+}
+
+func (s *maintenanceService) validateTicket(ticket *model.MaintenanceTicket) error {
+	ticket.Description = strings.TrimSpace(ticket.Description)
+	switch ticket.Kind {
+	case model.REPAIR:
+		if ticket.Description == "" {
+			return errors.New("a repair needs a description of the fault")
+		}
+	case model.PREVENTIVE:
+		if ticket.ScheduleID != nil && s.repo.GetScheduleById(*ticket.ScheduleID).ID == 0 {
+			return errors.New("maintenance schedule not found")
+		}
+	default:
+		return fmt.Errorf("unknown maintenance kind %q", ticket.Kind)
+	}
+	if ticket.Cost != nil && *ticket.Cost < 0 {
+		return errors.New("cost cannot be negative")
+	}
+	if ticket.Currency != nil {
+		currency := strings.ToUpper(strings.TrimSpace(*ticket.Currency))
+		ticket.Currency = &currency
+	}
+	return nil
+}
+
+func validateSchedule(schedule model.MaintenanceSchedule) error {
+	if (schedule.ItemID == nil) == (schedule.DeviceTypeID == nil) {
+		return errors.New("a maintenance schedule applies to either one item or one device type")
+	}
+	if schedule.IntervalDays <= 0 {
+		return errors.New("interval must be at least one day")
+	}
+	if strings.TrimSpace(schedule.Description) == "" {
+		return errors.New("maintenance schedule description is required")
+	}
+	return nil
+}
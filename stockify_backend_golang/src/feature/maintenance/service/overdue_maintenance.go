@@ -0,0 +1,66 @@
+package service
+
+import (
+	"slices"
+	"stockify_backend_golang/src/common/timeutil"
+	"stockify_backend_golang/src/feature/maintenance/model"
+	"time"
+)
+
+// FindOverdue lists, for every enabled schedule and every item it covers, the
+// maintenance whose due date was before today. An item is due IntervalDays
+// after the schedule was last done for it, or after it went into service.
+func FindOverdue(items []model.MaintainableItem, schedules []model.MaintenanceSchedule,
+	completed []model.CompletedMaintenance, now time.Time) []model.OverdueMaintenance {
+	type key struct{ item, schedule uint64 }
+	lastDone := make(map[key]time.Time)
+	for _, done := range completed {
+		k := key{done.ItemID, done.ScheduleID}
+		if last, ok := lastDone[k]; !ok || done.DoneAt.After(last) {
+			lastDone[k] = done.DoneAt
+		}
+	}
+
+	overdue := []model.OverdueMaintenance{}
+	for _, schedule := range schedules {
+		if !schedule.Enabled || schedule.IntervalDays <= 0 {
+			continue
+		}
+		for _, item := range items {
+			if !covers(schedule, item) {
+				continue
+			}
+			since := item.InServiceAt
+			var lastDoneAt *time.Time
+			if last, ok := lastDone[key{item.ItemID, schedule.ID}]; ok {
+				since = last
+				lastDoneAt = &last
+			}
+			dueAt := since.AddDate(0, 0, schedule.IntervalDays)
+			daysOverdue := timeutil.DaysBetween(dueAt, now)
+			if daysOverdue <= 0 {
+				continue
+			}
+			overdue = append(overdue, model.OverdueMaintenance{
+				ItemID:      item.ItemID,
+				AssetNo:     item.AssetNo,
+				ScheduleID:  schedule.ID,
+				Description: schedule.Description,
+				LastDoneAt:  lastDoneAt,
+				DueAt:       dueAt,
+				DaysOverdue: daysOverdue,
+			})
+		}
+	}
+	slices.SortStableFunc(overdue, func(a, b model.OverdueMaintenance) int {
+		return b.DaysOverdue - a.DaysOverdue
+	})
+	return overdue
+}
+
+func covers(schedule model.MaintenanceSchedule, item model.MaintainableItem) bool {
+	if schedule.ItemID != nil {
+		return *schedule.ItemID == item.ItemID
+	}
+	return schedule.DeviceTypeID != nil && item.DeviceTypeID != nil && *schedule.DeviceTypeID == *item.DeviceTypeID
+}
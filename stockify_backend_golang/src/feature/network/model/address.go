@@ -0,0 +1,114 @@
+package model
+
+import (
+	"fmt"
+	"net/netip"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"strconv"
+	"strings"
+)
+
+// NormalizeMAC turns a MAC address written with colons, hyphens, Cisco dots
+// or no separators at all into upper case colon form, AA:BB:CC:DD:EE:FF.
+func NormalizeMAC(mac string) (string, error) {
+	hex := strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '-', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(mac))
+	if len(hex) != 12 {
+		return "", fmt.Errorf("invalid MAC address %q", mac)
+	}
+	if _, err := strconv.ParseUint(hex, 16, 64); err != nil {
+		return "", fmt.Errorf("invalid MAC address %q", mac)
+	}
+	hex = strings.ToUpper(hex)
+	parts := make([]string, 6)
+	for i := range parts {
+		parts[i] = hex[i*2 : i*2+2]
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// NormalizeIpPort returns the canonical form of an address with an optional
+// port, such as "10.0.0.5", "10.0.0.5:8080" or "[fe80::1]:22". Zero padded
+// IPv4 octets are accepted and IPv4-mapped IPv6 addresses are unmapped.
+func NormalizeIpPort(ipPort string) (string, error) {
+	ipPort = strings.TrimSpace(ipPort)
+	if addrPort, err := netip.ParseAddrPort(unpadIPv4(ipPort)); err == nil {
+		return netip.AddrPortFrom(addrPort.Addr().Unmap(), addrPort.Port()).String(), nil
+	}
+	addr, err := netip.ParseAddr(strings.Trim(unpadIPv4(ipPort), "[]"))
+	if err != nil {
+		return "", fmt.Errorf("invalid IP address %q", ipPort)
+	}
+	return addr.Unmap().String(), nil
+}
+
+// IPOf returns the normalized address of an IpPort value without the port
+func IPOf(ipPort string) (string, error) {
+	normalized, err := NormalizeIpPort(ipPort)
+	if err != nil {
+		return "", err
+	}
+	if addrPort, err := netip.ParseAddrPort(normalized); err == nil {
+		return addrPort.Addr().String(), nil
+	}
+	return normalized, nil
+}
+
+// NormalizeHostName trims a host name and drops the trailing dot of a fully
+// qualified name. Host names compare case-insensitively, see FindDuplicates.
+func NormalizeHostName(hostName string) string {
+	return strings.TrimSuffix(strings.TrimSpace(hostName), ".")
+}
+
+// NormalizeItemAddresses rewrites the network fields of an item in their
+// normalized form. Values that cannot be parsed are kept as entered, so a
+// typo is never lost; FindDuplicates only compares the values it understands.
+func NormalizeItemAddresses(item *itemmodel.Item) {
+	normalize := func(field *string, fn func(string) (string, error)) *string {
+		if field == nil {
+			return nil
+		}
+		value := strings.TrimSpace(*field)
+		if value == "" {
+			return nil
+		}
+		if normalized, err := fn(value); err == nil {
+			value = normalized
+		}
+		return &value
+	}
+	item.MacAddress = normalize(item.MacAddress, NormalizeMAC)
+	item.IpPort = normalize(item.IpPort, NormalizeIpPort)
+	item.SwitchIpAddress = normalize(item.SwitchIpAddress, IPOf)
+	item.HostName = normalize(item.HostName, func(s string) (string, error) { return NormalizeHostName(s), nil })
+	item.SwitchPort = normalize(item.SwitchPort, func(s string) (string, error) { return s, nil })
+}
+
+// unpadIPv4 strips leading zeros from the octets of a dotted IPv4 address,
+// which netip rejects as ambiguous. Anything else is returned unchanged.
+func unpadIPv4(s string) string {
+	host, port, hasPort := strings.Cut(s, ":")
+	if hasPort && strings.Contains(port, ":") {
+		return s
+	}
+	octets := strings.Split(host, ".")
+	if len(octets) != 4 {
+		return s
+	}
+	for i, octet := range octets {
+		n, err := strconv.ParseUint(octet, 10, 8)
+		if err != nil {
+			return s
+		}
+		octets[i] = strconv.FormatUint(n, 10)
+	}
+	if hasPort {
+		return strings.Join(octets, ".") + ":" + port
+	}
+	return strings.Join(octets, ".")
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+)
+
+// DiscoverySource is the kind of file network discovery data is read from
+type DiscoverySource string
+
+const (
+	ARP_TABLE    DiscoverySource = "arp"   // /proc/net/arp or a dump of it
+	DHCPD_LEASES DiscoverySource = "dhcpd" // ISC dhcpd.leases
+	NMAP_XML     DiscoverySource = "nmap"  // nmap -oX output
+)
+
+var DiscoverySources = []DiscoverySource{ARP_TABLE, DHCPD_LEASES, NMAP_XML}
+
+// DiscoveredHost is a device seen on the network. Addresses are normalized;
+// any of them may be empty, e.g. nmap cannot see the MAC of a host behind a
+// router.
+type DiscoveredHost struct {
+	IP       string     `json:"IP"`
+	MAC      string     `json:"MAC"`
+	HostName string     `json:"HostName"`
+	SeenAt   *time.Time `json:"SeenAt,omitempty"`
+}
+
+func (h *DiscoveredHost) String() string {
+	return fmt.Sprintf("DiscoveredHost{IP: %s, MAC: %s, HostName: %s}", h.IP, h.MAC, h.HostName)
+}
+
+// AddressChange is a network field of an item that differs from what was
+// discovered. Current is nil when the item has no value yet.
+type AddressChange struct {
+	Field      AddressKind `json:"Field"`
+	Current    *string     `json:"Current,omitempty"`
+	Discovered string      `json:"Discovered"`
+}
+
+// ReconciledItem is an item matched to a discovered host whose addresses
+// differ from it
+type ReconciledItem struct {
+	Item      itemmodel.Item  `json:"Item"`
+	MatchedBy AddressKind     `json:"MatchedBy"`
+	Host      DiscoveredHost  `json:"Host"`
+	Changes   []AddressChange `json:"Changes"`
+}
+
+// ReconciliationReport compares discovered hosts with the inventory.
+//
+//   - Mismatches are items whose IP, MAC or host name differ from the host
+//     they were matched to; these are the updates Applied writes.
+//   - Unknown hosts match no item, Ambiguous hosts match several by host name.
+//   - Stale items have network addresses but were not seen. Only meaningful
+//     when the file covers every network the items live on.
+type ReconciliationReport struct {
+	Source     DiscoverySource  `json:"Source"`
+	HostCount  int              `json:"HostCount"`
+	Matched    int              `json:"Matched"`
+	Mismatches []ReconciledItem `json:"Mismatches"`
+	Unknown    []DiscoveredHost `json:"Unknown"`
+	Ambiguous  []DiscoveredHost `json:"Ambiguous"`
+	Stale      []itemmodel.Item `json:"Stale"`
+	Applied    bool             `json:"Applied"`
+}
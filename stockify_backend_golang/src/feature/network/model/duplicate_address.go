@@ -0,0 +1,20 @@
+package model
+
+import itemmodel "stockify_backend_golang/src/feature/item/model"
+
+// AddressKind names the network field two items were found sharing
+type AddressKind string
+
+const (
+	IP_ADDRESS  AddressKind = "ip"
+	MAC_ADDRESS AddressKind = "mac"
+	HOST_NAME   AddressKind = "hostname"
+)
+
+// DuplicateAddress is an IP, MAC or host name used by more than one item.
+// Value is the normalized form the items were compared by.
+type DuplicateAddress struct {
+	Kind  AddressKind      `json:"Kind"`
+	Value string           `json:"Value"`
+	Items []itemmodel.Item `json:"Items"`
+}
@@ -0,0 +1,26 @@
+package model
+
+import itemmodel "stockify_backend_golang/src/feature/item/model"
+
+// SwitchPort is a port of a switch with the devices patched into it. More
+// than one device on a port is usually a stale record or a desk switch.
+type SwitchPort struct {
+	Port          string           `json:"Port"`
+	FacePlateName *string          `json:"FacePlateName,omitempty"`
+	Devices       []itemmodel.Item `json:"Devices"`
+}
+
+// SwitchPortMap lists the ports of a switch in port order. Devices that name
+// the switch but no port are listed under Unpatched.
+type SwitchPortMap struct {
+	Switch    itemmodel.Item   `json:"Switch"`
+	Ports     []SwitchPort     `json:"Ports"`
+	Unpatched []itemmodel.Item `json:"Unpatched"`
+}
+
+// NetworkOverview is the port map of every switch, plus the devices whose
+// SwitchIpAddress does not match any switch in the inventory
+type NetworkOverview struct {
+	Switches      []SwitchPortMap  `json:"Switches"`
+	UnknownSwitch []itemmodel.Item `json:"UnknownSwitch"`
+}
@@ -0,0 +1,7 @@
+package repository
+
+import itemmodel "stockify_backend_golang/src/feature/item/model"
+
+type NetworkRepository interface {
+	GetNetworkItems(switchTypeId uint64) ([]itemmodel.Item, error)
+}
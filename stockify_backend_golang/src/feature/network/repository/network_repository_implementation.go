@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/common/db"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+)
+
+type networkRepository struct{}
+
+func NetworkRepositoryImplementation() NetworkRepository {
+	return &networkRepository{}
+}
+
+// GetNetworkItems returns the items that have any network field set and the
+// switches, the items of the given device type, leaving out disposed items
+// whose addresses have been given up.
+func (r *networkRepository) GetNetworkItems(switchTypeId uint64) ([]itemmodel.Item, error) {
+	var items []itemmodel.Item
+	err := db.DB.
+		Where("asset_status <> ?", itemmodel.DISPOSED).
+		Where("COALESCE(host_name, '') <> '' OR COALESCE(ip_port, '') <> '' OR COALESCE(mac_address, '') <> '' "+
+			"OR COALESCE(switch_ip_address, '') <> '' OR device_type_id = ?", switchTypeId).
+		Order("asset_no").
+		Find(&items).Error
+	return items, err
+}
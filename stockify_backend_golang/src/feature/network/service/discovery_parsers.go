@@ -0,0 +1,215 @@
+package service
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"stockify_backend_golang/src/feature/network/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDiscovery reads the hosts in a discovery file of the given source.
+// Hosts are merged by MAC address, or by IP when there is no MAC, the later
+// entry winning, so a lease file with many leases per device yields its most
+// recent one.
+func ParseDiscovery(source model.DiscoverySource, r io.Reader) ([]model.DiscoveredHost, error) {
+	var hosts []model.DiscoveredHost
+	var err error
+	switch source {
+	case model.ARP_TABLE:
+		hosts, err = parseARP(r)
+	case model.DHCPD_LEASES:
+		hosts, err = parseDhcpdLeases(r)
+	case model.NMAP_XML:
+		hosts, err = parseNmapXML(r)
+	default:
+		return nil, fmt.Errorf("unknown discovery source %q", source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mergeHosts(hosts), nil
+}
+
+// parseARP reads the table format of /proc/net/arp:
+//
+//	IP address       HW type     Flags       HW address            Mask     Device
+//	192.168.1.1      0x1         0x2         aa:bb:cc:dd:ee:ff     *        eth0
+//
+// Incomplete entries, flagged 0x0 or with an all-zero MAC, are skipped.
+func parseARP(r io.Reader) ([]model.DiscoveredHost, error) {
+	var hosts []model.DiscoveredHost
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || strings.EqualFold(fields[0], "IP") {
+			continue
+		}
+		if fields[2] == "0x0" {
+			continue
+		}
+		ip, err := model.IPOf(fields[0])
+		if err != nil {
+			continue
+		}
+		mac, err := model.NormalizeMAC(fields[3])
+		if err != nil || mac == "00:00:00:00:00:00" {
+			continue
+		}
+		hosts = append(hosts, model.DiscoveredHost{IP: ip, MAC: mac})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ARP table: %w", err)
+	}
+	return hosts, nil
+}
+
+// parseDhcpdLeases reads an ISC dhcpd.leases file. Leases that are free,
+// expired or abandoned are skipped; SeenAt is the lease start.
+func parseDhcpdLeases(r io.Reader) ([]model.DiscoveredHost, error) {
+	var hosts []model.DiscoveredHost
+	var lease *model.DiscoveredHost
+	active := true
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimSuffix(line, ";"))
+		if len(fields) == 0 {
+			continue
+		}
+		switch {
+		case fields[0] == "lease" && len(fields) >= 2:
+			ip, err := model.IPOf(fields[1])
+			if err != nil {
+				lease = nil
+				continue
+			}
+			lease = &model.DiscoveredHost{IP: ip}
+			active = true
+		case lease == nil:
+			continue
+		case fields[0] == "}":
+			if active && (lease.MAC != "" || lease.HostName != "") {
+				hosts = append(hosts, *lease)
+			}
+			lease = nil
+		case fields[0] == "starts" && len(fields) >= 2:
+			lease.SeenAt = parseLeaseTime(fields[1:])
+		case fields[0] == "binding" && len(fields) >= 3 && fields[1] == "state":
+			active = fields[2] == "active"
+		case fields[0] == "hardware" && len(fields) >= 3 && fields[1] == "ethernet":
+			if mac, err := model.NormalizeMAC(fields[2]); err == nil {
+				lease.MAC = mac
+			}
+		case fields[0] == "client-hostname" && len(fields) >= 2:
+			lease.HostName = model.NormalizeHostName(strings.Trim(strings.Join(fields[1:], " "), `"`))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read lease file: %w", err)
+	}
+	return hosts, nil
+}
+
+// parseLeaseTime reads "4 2024/01/04 10:00:00" (weekday, date and time in
+// UTC) or "epoch 1704362400". Anything else, such as "never", is nil.
+func parseLeaseTime(fields []string) *time.Time {
+	if fields[0] == "epoch" && len(fields) >= 2 {
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil
+		}
+		t := time.Unix(seconds, 0).UTC()
+		return &t
+	}
+	if len(fields) < 3 {
+		return nil
+	}
+	t, err := time.Parse("2006/01/02 15:04:05", fields[1]+" "+fields[2])
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+type nmapRun struct {
+	Hosts []struct {
+		EndTime int64 `xml:"endtime,attr"`
+		Status  struct {
+			State string `xml:"state,attr"`
+		} `xml:"status"`
+		Addresses []struct {
+			Addr     string `xml:"addr,attr"`
+			AddrType string `xml:"addrtype,attr"`
+		} `xml:"address"`
+		HostNames []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"hostnames>hostname"`
+	} `xml:"host"`
+}
+
+// parseNmapXML reads the hosts that are up from nmap -oX output, taking the
+// first host name nmap reports for each.
+func parseNmapXML(r io.Reader) ([]model.DiscoveredHost, error) {
+	var run nmapRun
+	if err := xml.NewDecoder(r).Decode(&run); err != nil {
+		return nil, fmt.Errorf("failed to read nmap XML: %w", err)
+	}
+	var hosts []model.DiscoveredHost
+	for _, h := range run.Hosts {
+		if h.Status.State != "" && h.Status.State != "up" {
+			continue
+		}
+		var host model.DiscoveredHost
+		for _, address := range h.Addresses {
+			switch address.AddrType {
+			case "ipv4", "ipv6":
+				if ip, err := model.IPOf(address.Addr); err == nil && host.IP == "" {
+					host.IP = ip
+				}
+			case "mac":
+				if mac, err := model.NormalizeMAC(address.Addr); err == nil {
+					host.MAC = mac
+				}
+			}
+		}
+		if len(h.HostNames) > 0 {
+			host.HostName = model.NormalizeHostName(h.HostNames[0].Name)
+		}
+		if h.EndTime > 0 {
+			t := time.Unix(h.EndTime, 0).UTC()
+			host.SeenAt = &t
+		}
+		if host.IP != "" || host.MAC != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+func mergeHosts(hosts []model.DiscoveredHost) []model.DiscoveredHost {
+	merged := []model.DiscoveredHost{}
+	index := map[string]int{}
+	for _, host := range hosts {
+		key := "mac:" + host.MAC
+		if host.MAC == "" {
+			key = "ip:" + host.IP
+		}
+		if i, seen := index[key]; seen {
+			if host.HostName == "" {
+				host.HostName = merged[i].HostName
+			}
+			merged[i] = host
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, host)
+	}
+	return merged
+}
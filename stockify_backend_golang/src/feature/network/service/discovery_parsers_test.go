@@ -0,0 +1,54 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDhcpdLeases(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "bare semicolon",
+			input: ";\n",
+			want:  nil,
+		},
+		{
+			name: "active lease",
+			input: `lease 10.0.0.5 {
+  starts 4 2024/01/04 10:00:00;
+  binding state active;
+  hardware ethernet 00:11:22:AA:BB:CC;
+  ;
+  client-hostname "pc-01";
+}`,
+			want: []string{"10.0.0.5 00:11:22:AA:BB:CC"},
+		},
+		{
+			name: "free lease",
+			input: `lease 10.0.0.6 {
+  binding state free;
+  hardware ethernet 00:11:22:aa:bb:cd;
+}`,
+			want: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hosts, err := parseDhcpdLeases(strings.NewReader(test.input))
+			if err != nil {
+				t.Fatalf("parseDhcpdLeases: %v", err)
+			}
+			var got []string
+			for _, host := range hosts {
+				got = append(got, host.IP+" "+host.MAC)
+			}
+			if strings.Join(got, "|") != strings.Join(test.want, "|") {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
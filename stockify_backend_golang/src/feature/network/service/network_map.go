@@ -0,0 +1,161 @@
+package service
+
+import (
+	"sort"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/network/model"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// FindDuplicates returns every IP address, MAC address and host name shared
+// by more than one item. IPs are compared without their port and host names
+// case-insensitively; values that do not parse are left out.
+func FindDuplicates(items []itemmodel.Item) []model.DuplicateAddress {
+	type key struct {
+		kind  model.AddressKind
+		value string
+	}
+	groups := map[key][]itemmodel.Item{}
+	var order []key
+	add := func(kind model.AddressKind, value string, item itemmodel.Item) {
+		k := key{kind, value}
+		if _, seen := groups[k]; !seen {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], item)
+	}
+	for _, item := range items {
+		if item.IpPort != nil {
+			if ip, err := model.IPOf(*item.IpPort); err == nil {
+				add(model.IP_ADDRESS, ip, item)
+			}
+		}
+		if item.MacAddress != nil {
+			if mac, err := model.NormalizeMAC(*item.MacAddress); err == nil {
+				add(model.MAC_ADDRESS, mac, item)
+			}
+		}
+		if item.HostName != nil {
+			if host := strings.ToLower(model.NormalizeHostName(*item.HostName)); host != "" {
+				add(model.HOST_NAME, host, item)
+			}
+		}
+	}
+
+	duplicates := []model.DuplicateAddress{}
+	for _, k := range order {
+		if len(groups[k]) > 1 {
+			duplicates = append(duplicates, model.DuplicateAddress{Kind: k.kind, Value: k.value, Items: groups[k]})
+		}
+	}
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		if duplicates[i].Kind != duplicates[j].Kind {
+			return duplicates[i].Kind < duplicates[j].Kind
+		}
+		return duplicates[i].Value < duplicates[j].Value
+	})
+	return duplicates
+}
+
+// BuildNetworkOverview matches the SwitchIpAddress of every device to the IP
+// of a switch, an item of the given device type, and lays the devices out on
+// the switch ports.
+func BuildNetworkOverview(items []itemmodel.Item, switchTypeId uint64) model.NetworkOverview {
+	overview := model.NetworkOverview{Switches: []model.SwitchPortMap{}, UnknownSwitch: []itemmodel.Item{}}
+	switchByIP := map[string]int{}
+	for _, item := range items {
+		if item.DeviceTypeID == nil || *item.DeviceTypeID != switchTypeId || item.IpPort == nil {
+			continue
+		}
+		ip, err := model.IPOf(*item.IpPort)
+		if err != nil {
+			continue
+		}
+		if _, taken := switchByIP[ip]; taken {
+			// Two switches on one IP show up in FindDuplicates; patch to the first
+			continue
+		}
+		switchByIP[ip] = len(overview.Switches)
+		overview.Switches = append(overview.Switches, model.SwitchPortMap{
+			Switch: item, Ports: []model.SwitchPort{}, Unpatched: []itemmodel.Item{},
+		})
+	}
+
+	portIndex := make([]map[string]int, len(overview.Switches))
+	for i := range portIndex {
+		portIndex[i] = map[string]int{}
+	}
+	for _, item := range items {
+		if item.SwitchIpAddress == nil {
+			continue
+		}
+		ip, err := model.IPOf(*item.SwitchIpAddress)
+		index, known := switchByIP[ip]
+		if err != nil || !known {
+			overview.UnknownSwitch = append(overview.UnknownSwitch, item)
+			continue
+		}
+		portMap := &overview.Switches[index]
+		port := ""
+		if item.SwitchPort != nil {
+			port = strings.TrimSpace(*item.SwitchPort)
+		}
+		if port == "" {
+			portMap.Unpatched = append(portMap.Unpatched, item)
+			continue
+		}
+		p, seen := portIndex[index][port]
+		if !seen {
+			p = len(portMap.Ports)
+			portIndex[index][port] = p
+			portMap.Ports = append(portMap.Ports, model.SwitchPort{Port: port, Devices: []itemmodel.Item{}})
+		}
+		portMap.Ports[p].Devices = append(portMap.Ports[p].Devices, item)
+		if portMap.Ports[p].FacePlateName == nil {
+			portMap.Ports[p].FacePlateName = item.FacePlateName
+		}
+	}
+
+	for _, portMap := range overview.Switches {
+		sort.SliceStable(portMap.Ports, func(i, j int) bool {
+			return portLess(portMap.Ports[i].Port, portMap.Ports[j].Port)
+		})
+	}
+	return overview
+}
+
+// portLess orders port names the way they are printed on the switch, so that
+// Gi1/0/2 comes before Gi1/0/10.
+func portLess(a, b string) bool {
+	ar, br := portRuns(a), portRuns(b)
+	for i := 0; i < len(ar) && i < len(br); i++ {
+		if ar[i] == br[i] {
+			continue
+		}
+		an, aErr := strconv.Atoi(ar[i])
+		bn, bErr := strconv.Atoi(br[i])
+		if aErr == nil && bErr == nil && an != bn {
+			return an < bn
+		}
+		return strings.ToLower(ar[i]) < strings.ToLower(br[i])
+	}
+	return len(ar) < len(br)
+}
+
+// portRuns splits a port name into runs of digits and non-digits
+func portRuns(port string) []string {
+	var runs []string
+	start := 0
+	for i, r := range port {
+		if i > start && unicode.IsDigit(r) != unicode.IsDigit(rune(port[i-1])) {
+			runs = append(runs, port[start:i])
+			start = i
+		}
+	}
+	if start < len(port) {
+		runs = append(runs, port[start:])
+	}
+	return runs
+}
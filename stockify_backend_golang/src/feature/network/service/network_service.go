@@ -0,0 +1,14 @@
+package service
+
+import (
+	"io"
+	"stockify_backend_golang/src/feature/network/model"
+)
+
+type NetworkService interface {
+	GetDuplicateAddresses() ([]model.DuplicateAddress, error)
+	GetSwitchPortMap(switchId uint64) (model.SwitchPortMap, error)
+	GetNetworkOverview() (model.NetworkOverview, error)
+	NormalizeAllAddresses() (int, error)
+	ReconcileDiscovery(source model.DiscoverySource, r io.Reader, apply bool) (model.ReconciliationReport, error)
+}
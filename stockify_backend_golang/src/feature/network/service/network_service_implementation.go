@@ -0,0 +1,148 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	devicetyperepository "stockify_backend_golang/src/feature/devicetype/repository"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/network/model"
+	"stockify_backend_golang/src/feature/network/repository"
+)
+
+type networkService struct {
+	repo           repository.NetworkRepository
+	itemService    itemservice.ItemService
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository
+}
+
+func NetworkServiceImplementation(
+	repo repository.NetworkRepository,
+	itemService itemservice.ItemService,
+	deviceTypeRepo devicetyperepository.DeviceTypeRepository,
+) NetworkService {
+	return &networkService{repo: repo, itemService: itemService, deviceTypeRepo: deviceTypeRepo}
+}
+
+// switchTypeId is the catalog id of the built-in Switch device type, which
+// keeps working when the type's code is renamed. It is 0 when the type was
+// deleted, leaving no item a switch.
+func (s *networkService) switchTypeId() uint64 {
+	return s.deviceTypeRepo.GetBuiltInDeviceType(string(itemmodel.SWITCH)).ID
+}
+
+func (s *networkService) GetDuplicateAddresses() ([]model.DuplicateAddress, error) {
+	items, err := s.repo.GetNetworkItems(s.switchTypeId())
+	if err != nil {
+		return nil, err
+	}
+	return FindDuplicates(items), nil
+}
+
+// GetSwitchPortMap returns the devices patched into a switch, by port. The
+// switch must be a Switch item with an IP address.
+func (s *networkService) GetSwitchPortMap(switchId uint64) (model.SwitchPortMap, error) {
+	item := s.itemService.GetItemById(switchId)
+	if item.ID == 0 {
+		return model.SwitchPortMap{}, errors.New("item not found")
+	}
+	switchTypeId := s.switchTypeId()
+	if item.DeviceTypeID == nil || *item.DeviceTypeID != switchTypeId {
+		return model.SwitchPortMap{}, errors.New("item is not a switch")
+	}
+	if item.IpPort == nil {
+		return model.SwitchPortMap{}, errors.New("switch has no IP address")
+	}
+	items, err := s.repo.GetNetworkItems(switchTypeId)
+	if err != nil {
+		return model.SwitchPortMap{}, err
+	}
+	overview := BuildNetworkOverview(items, switchTypeId)
+	for _, portMap := range overview.Switches {
+		if portMap.Switch.ID == switchId {
+			return portMap, nil
+		}
+	}
+	return model.SwitchPortMap{Switch: item, Ports: []model.SwitchPort{}, Unpatched: []itemmodel.Item{}}, nil
+}
+
+func (s *networkService) GetNetworkOverview() (model.NetworkOverview, error) {
+	switchTypeId := s.switchTypeId()
+	items, err := s.repo.GetNetworkItems(switchTypeId)
+	if err != nil {
+		return model.NetworkOverview{}, err
+	}
+	return BuildNetworkOverview(items, switchTypeId), nil
+}
+
+// NormalizeAllAddresses rewrites the network fields of stored items in their
+// normalized form, for data entered before normalization on save. It returns
+// the number of items changed.
+func (s *networkService) NormalizeAllAddresses() (int, error) {
+	items, err := s.repo.GetNetworkItems(s.switchTypeId())
+	if err != nil {
+		return 0, err
+	}
+	changed := 0
+	for _, item := range items {
+		before := item
+		model.NormalizeItemAddresses(&item)
+		if sameValue(before.HostName, item.HostName) && sameValue(before.IpPort, item.IpPort) &&
+			sameValue(before.MacAddress, item.MacAddress) && sameValue(before.SwitchIpAddress, item.SwitchIpAddress) &&
+			sameValue(before.SwitchPort, item.SwitchPort) {
+			continue
+		}
+		if err := s.itemService.UpdateItem(item); err != nil {
+			return changed, err
+		}
+		changed++
+	}
+	return changed, nil
+}
+
+// ReconcileDiscovery compares a discovery file with the inventory. With apply
+// the mismatched addresses are written to the items; the report then lists
+// what was changed.
+func (s *networkService) ReconcileDiscovery(source model.DiscoverySource, r io.Reader, apply bool) (model.ReconciliationReport, error) {
+	hosts, err := ParseDiscovery(source, r)
+	if err != nil {
+		return model.ReconciliationReport{}, err
+	}
+	items, err := s.repo.GetNetworkItems(s.switchTypeId())
+	if err != nil {
+		return model.ReconciliationReport{}, err
+	}
+	report := Reconcile(items, hosts)
+	report.Source = source
+	if !apply {
+		return report, nil
+	}
+	for _, mismatch := range report.Mismatches {
+		item := s.itemService.GetItemById(mismatch.Item.ID)
+		for _, change := range mismatch.Changes {
+			value := change.Discovered
+			switch change.Field {
+			case model.IP_ADDRESS:
+				item.IpPort = &value
+			case model.MAC_ADDRESS:
+				item.MacAddress = &value
+			case model.HOST_NAME:
+				item.HostName = &value
+			}
+		}
+		item.AssignedTo = nil
+		if err := s.itemService.UpdateItem(item); err != nil {
+			return report, fmt.Errorf("failed to update item %s: %w", item.AssetNo, err)
+		}
+	}
+	report.Applied = true
+	return report, nil
+}
+
+func sameValue(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
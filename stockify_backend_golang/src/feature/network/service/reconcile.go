@@ -0,0 +1,128 @@
+package service
+
+import (
+	"net/netip"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/network/model"
+	"strings"
+)
+
+// Reconcile matches discovered hosts to items, by MAC address first and then
+// by host name, and reports what differs. A host that only has an IP
+// confirms the item with that IP but never changes it. Host names compare
+// case-insensitively by their first label, so "pc1" matches
+// "PC1.corp.example".
+func Reconcile(items []itemmodel.Item, hosts []model.DiscoveredHost) model.ReconciliationReport {
+	report := model.ReconciliationReport{
+		HostCount:  len(hosts),
+		Mismatches: []model.ReconciledItem{},
+		Unknown:    []model.DiscoveredHost{},
+		Ambiguous:  []model.DiscoveredHost{},
+		Stale:      []itemmodel.Item{},
+	}
+	byMAC := map[string]int{}
+	byHost := map[string][]int{}
+	byIP := map[string]int{}
+	for i, item := range items {
+		if item.MacAddress != nil {
+			if mac, err := model.NormalizeMAC(*item.MacAddress); err == nil {
+				byMAC[mac] = i
+			}
+		}
+		if item.HostName != nil {
+			if key := hostKey(*item.HostName); key != "" {
+				byHost[key] = append(byHost[key], i)
+			}
+		}
+		if item.IpPort != nil {
+			if ip, err := model.IPOf(*item.IpPort); err == nil {
+				byIP[ip] = i
+			}
+		}
+	}
+
+	seen := make([]bool, len(items))
+	match := func(i int, host model.DiscoveredHost, by model.AddressKind) {
+		seen[i] = true
+		report.Matched++
+		if changes := addressChanges(items[i], host); len(changes) > 0 {
+			report.Mismatches = append(report.Mismatches, model.ReconciledItem{
+				Item: items[i], MatchedBy: by, Host: host, Changes: changes,
+			})
+		}
+	}
+
+	// MAC matches are certain, so they go first and claim their items
+	var rest []model.DiscoveredHost
+	for _, host := range hosts {
+		if i, ok := byMAC[host.MAC]; ok && host.MAC != "" && !seen[i] {
+			match(i, host, model.MAC_ADDRESS)
+			continue
+		}
+		rest = append(rest, host)
+	}
+	for _, host := range rest {
+		if candidates := byHost[hostKey(host.HostName)]; host.HostName != "" && len(candidates) > 0 {
+			if len(candidates) > 1 {
+				report.Ambiguous = append(report.Ambiguous, host)
+				continue
+			}
+			if i := candidates[0]; !seen[i] {
+				match(i, host, model.HOST_NAME)
+				continue
+			}
+		}
+		if i, ok := byIP[host.IP]; ok && host.IP != "" && host.MAC == "" && host.HostName == "" {
+			seen[i] = true
+			report.Matched++
+			continue
+		}
+		report.Unknown = append(report.Unknown, host)
+	}
+
+	for i, item := range items {
+		if !seen[i] && (item.MacAddress != nil || item.IpPort != nil) {
+			report.Stale = append(report.Stale, item)
+		}
+	}
+	return report
+}
+
+// addressChanges lists the fields of the item that differ from the host.
+// Discovered holds the value to write: an item's IpPort keeps its port.
+func addressChanges(item itemmodel.Item, host model.DiscoveredHost) []model.AddressChange {
+	var changes []model.AddressChange
+	if host.IP != "" {
+		current := ""
+		if item.IpPort != nil {
+			current, _ = model.IPOf(*item.IpPort)
+		}
+		if current != host.IP {
+			discovered := host.IP
+			if item.IpPort != nil {
+				if addrPort, err := netip.ParseAddrPort(*item.IpPort); err == nil {
+					discovered = netip.AddrPortFrom(netip.MustParseAddr(host.IP), addrPort.Port()).String()
+				}
+			}
+			changes = append(changes, model.AddressChange{Field: model.IP_ADDRESS, Current: item.IpPort, Discovered: discovered})
+		}
+	}
+	if host.MAC != "" {
+		current := ""
+		if item.MacAddress != nil {
+			current, _ = model.NormalizeMAC(*item.MacAddress)
+		}
+		if current != host.MAC {
+			changes = append(changes, model.AddressChange{Field: model.MAC_ADDRESS, Current: item.MacAddress, Discovered: host.MAC})
+		}
+	}
+	if host.HostName != "" && (item.HostName == nil || hostKey(*item.HostName) != hostKey(host.HostName)) {
+		changes = append(changes, model.AddressChange{Field: model.HOST_NAME, Current: item.HostName, Discovered: host.HostName})
+	}
+	return changes
+}
+
+func hostKey(hostName string) string {
+	short, _, _ := strings.Cut(model.NormalizeHostName(hostName), ".")
+	return strings.ToLower(short)
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Note is a timestamped free-text remark on an item or a user, such as
+// "screen cracked, replaced 2025-03". A note with ReplyToID set answers
+// another note on the same item or user, forming a comment thread.
+type Note struct {
+	gorm.Model
+	ID        uint64     `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID    *uint64    `gorm:"index" json:"ItemID,omitempty"`
+	UserID    *uint64    `gorm:"index" json:"UserID,omitempty"`
+	ReplyToID *uint64    `gorm:"index" json:"ReplyToID,omitempty"`
+	Author    string     `json:"Author"`
+	Body      string     `json:"Body"`
+	EditedAt  *time.Time `json:"EditedAt,omitempty"`
+}
+
+func (n *Note) String() string {
+	return fmt.Sprintf("Note{ID: %d, Author: %s, CreatedAt: %s}", n.ID, n.Author, n.CreatedAt.Format(time.DateTime))
+}
+
+// NoteRevision keeps the text a note had before an edit. ReplacedAt is when
+// the edit replaced it.
+type NoteRevision struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement" json:"ID"`
+	NoteID     uint64    `gorm:"index" json:"NoteID"`
+	Body       string    `json:"Body"`
+	ReplacedAt time.Time `json:"ReplacedAt"`
+}
+
+// NoteThread is a note with its replies, oldest first
+type NoteThread struct {
+	Note    Note         `json:"Note"`
+	Replies []NoteThread `json:"Replies"`
+}
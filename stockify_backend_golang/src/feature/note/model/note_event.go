@@ -0,0 +1,16 @@
+package model
+
+import "stockify_backend_golang/src/common/event"
+
+const (
+	NOTE_ADDED   event.Type = "note.added"
+	NOTE_UPDATED event.Type = "note.updated"
+	NOTE_DELETED event.Type = "note.deleted"
+)
+
+// NoteEvent is the data published with note events. Previous holds the text
+// before an edit.
+type NoteEvent struct {
+	Note     Note  `json:"note"`
+	Previous *Note `json:"previous,omitempty"`
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/note/model"
+)
+
+type NoteRepository interface {
+	GetNoteById(id uint64) model.Note
+	GetNotesByItemId(itemId uint64) []model.Note
+	GetNotesByUserId(userId uint64) []model.Note
+	GetNotesByItemIds(itemIds []uint64) []model.Note
+	GetReplies(id uint64) []model.Note
+	GetRevisions(noteId uint64) []model.NoteRevision
+	AddNote(note *model.Note, history *lifecyclemodel.StatusHistory) error
+	UpdateNote(note model.Note, revision model.NoteRevision, history *lifecyclemodel.StatusHistory) error
+	DeleteNotesByIds(ids []uint64, history *lifecyclemodel.StatusHistory) error
+}
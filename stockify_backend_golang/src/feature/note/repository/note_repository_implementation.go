@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/note/model"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Note{}, &model.NoteRevision{})
+	if err != nil {
+		log.Fatal("Failed to migrate Note table: " + err.Error())
+	}
+}
+
+// ItemSearchQuery selects the ids of items with a note containing the text;
+// the single argument is a LIKE pattern.
+const ItemSearchQuery = `SELECT item_id FROM notes
+	WHERE item_id IS NOT NULL AND deleted_at IS NULL AND LOWER(body) LIKE LOWER(?)`
+
+// UserSearchQuery selects the ids of users with a note containing the text;
+// the single argument is a LIKE pattern.
+const UserSearchQuery = `SELECT user_id FROM notes
+	WHERE user_id IS NOT NULL AND deleted_at IS NULL AND LOWER(body) LIKE LOWER(?)`
+
+// OldestFirst orders notes preloaded with an item or user the way they are
+// listed
+func OldestFirst(tx *gorm.DB) *gorm.DB {
+	return tx.Order("created_at, id")
+}
+
+type noteRepository struct{}
+
+func NoteRepositoryImplementation() NoteRepository {
+	return &noteRepository{}
+}
+
+func (r *noteRepository) GetNoteById(id uint64) model.Note {
+	var note model.Note
+	db.DB.First(&note, id)
+	return note
+}
+
+func (r *noteRepository) GetNotesByItemId(itemId uint64) []model.Note {
+	var notes []model.Note
+	db.DB.Where("item_id = ?", itemId).Order("created_at, id").Find(&notes)
+	return notes
+}
+
+func (r *noteRepository) GetNotesByUserId(userId uint64) []model.Note {
+	var notes []model.Note
+	db.DB.Where("user_id = ?", userId).Order("created_at, id").Find(&notes)
+	return notes
+}
+
+func (r *noteRepository) GetNotesByItemIds(itemIds []uint64) []model.Note {
+	var notes []model.Note
+	if len(itemIds) == 0 {
+		return notes
+	}
+	db.DB.Where("item_id IN ?", itemIds).Order("created_at, id").Find(&notes)
+	return notes
+}
+
+func (r *noteRepository) GetReplies(id uint64) []model.Note {
+	var notes []model.Note
+	db.DB.Where("reply_to_id = ?", id).Order("created_at, id").Find(&notes)
+	return notes
+}
+
+// GetRevisions returns the earlier texts of a note, oldest first
+func (r *noteRepository) GetRevisions(noteId uint64) []model.NoteRevision {
+	var revisions []model.NoteRevision
+	db.DB.Where("note_id = ?", noteId).Order("replaced_at, id").Find(&revisions)
+	return revisions
+}
+
+// AddNote saves the note together with the status history entry recording
+// it on its item, if any, in one transaction. The same holds for the other
+// changes below.
+func (r *noteRepository) AddNote(note *model.Note, history *lifecyclemodel.StatusHistory) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(note).Error; err != nil {
+			return err
+		}
+		return addHistory(tx, history)
+	})
+}
+
+// UpdateNote saves the edited note and keeps its previous text as a revision
+func (r *noteRepository) UpdateNote(note model.Note, revision model.NoteRevision, history *lifecyclemodel.StatusHistory) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&revision).Error; err != nil {
+			return err
+		}
+		if err := tx.Save(&note).Error; err != nil {
+			return err
+		}
+		return addHistory(tx, history)
+	})
+}
+
+func (r *noteRepository) DeleteNotesByIds(ids []uint64, history *lifecyclemodel.StatusHistory) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.Note{}, ids).Error; err != nil {
+			return err
+		}
+		return addHistory(tx, history)
+	})
+}
+
+func addHistory(tx *gorm.DB, history *lifecyclemodel.StatusHistory) error {
+	if history == nil {
+		return nil
+	}
+	return tx.Create(history).Error
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/note/model"
+)
+
+type NoteService interface {
+	GetNoteById(id uint64) model.Note
+	GetItemNotes(itemId uint64) []model.NoteThread
+	GetUserNotes(userId uint64) []model.NoteThread
+	AddNote(note model.Note) (model.Note, error)
+	GetNoteRevisions(id uint64) ([]model.NoteRevision, error)
+	UpdateNote(id uint64, body string) (model.Note, error)
+	DeleteNoteById(id uint64) error
+}
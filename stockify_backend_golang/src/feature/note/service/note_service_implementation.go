@@ -0,0 +1,182 @@
+package service
+
+import (
+	"errors"
+	"stockify_backend_golang/src/common/event"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/note/model"
+	"stockify_backend_golang/src/feature/note/repository"
+	userrepository "stockify_backend_golang/src/feature/user/repository"
+	"strings"
+	"time"
+)
+
+type noteService struct {
+	repo        repository.NoteRepository
+	itemService itemservice.ItemService
+	userRepo    userrepository.UserRepository
+}
+
+func NoteServiceImplementation(
+	repo repository.NoteRepository,
+	itemService itemservice.ItemService,
+	userRepo userrepository.UserRepository,
+) NoteService {
+	return &noteService{repo: repo, itemService: itemService, userRepo: userRepo}
+}
+
+func (s *noteService) GetNoteById(id uint64) model.Note {
+	return s.repo.GetNoteById(id)
+}
+
+func (s *noteService) GetItemNotes(itemId uint64) []model.NoteThread {
+	return Threads(s.repo.GetNotesByItemId(itemId))
+}
+
+func (s *noteService) GetUserNotes(userId uint64) []model.NoteThread {
+	return Threads(s.repo.GetNotesByUserId(userId))
+}
+
+// AddNote adds a note to the item or user it names. A reply is added to the
+// item or user of the note it answers, so neither needs to be given.
+func (s *noteService) AddNote(note model.Note) (model.Note, error) {
+	note.Body = strings.TrimSpace(note.Body)
+	note.Author = strings.TrimSpace(note.Author)
+	if note.Body == "" {
+		return note, errors.New("note text is required")
+	}
+	if note.ReplyToID != nil {
+		parent := s.repo.GetNoteById(*note.ReplyToID)
+		if parent.ID == 0 {
+			return note, errors.New("note replied to not found")
+		}
+		note.ItemID, note.UserID = parent.ItemID, parent.UserID
+	}
+	switch {
+	case note.ItemID == nil && note.UserID == nil:
+		return note, errors.New("a note belongs to an item or a user")
+	case note.ItemID != nil && note.UserID != nil:
+		return note, errors.New("a note belongs to either an item or a user, not both")
+	case note.ItemID != nil && s.itemService.GetItemById(*note.ItemID).ID == 0:
+		return note, errors.New("item not found")
+	case note.UserID != nil && s.userRepo.GetUserById(*note.UserID).ID == 0:
+		return note, errors.New("user not found")
+	}
+	note.ID = 0
+	note.EditedAt = nil
+	action := "Note added"
+	if note.Author != "" {
+		action += " by " + note.Author
+	}
+	if err := s.repo.AddNote(&note, s.itemHistory(note, action)); err != nil {
+		return note, err
+	}
+	event.Publish(model.NOTE_ADDED, model.NoteEvent{Note: note})
+	return note, nil
+}
+
+// GetNoteRevisions returns the texts a note had before its edits, oldest
+// first.
+func (s *noteService) GetNoteRevisions(id uint64) ([]model.NoteRevision, error) {
+	if s.repo.GetNoteById(id).ID == 0 {
+		return nil, errors.New("note not found")
+	}
+	return s.repo.GetRevisions(id), nil
+}
+
+// UpdateNote replaces the text of a note. The previous text is kept as a
+// revision and published with the update event.
+func (s *noteService) UpdateNote(id uint64, body string) (model.Note, error) {
+	note := s.repo.GetNoteById(id)
+	if note.ID == 0 {
+		return note, errors.New("note not found")
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return note, errors.New("note text is required")
+	}
+	if body == note.Body {
+		return note, nil
+	}
+	previous := note
+	now := time.Now()
+	note.Body = body
+	note.EditedAt = &now
+	revision := model.NoteRevision{NoteID: note.ID, Body: previous.Body, ReplacedAt: now}
+	if err := s.repo.UpdateNote(note, revision, s.itemHistory(note, "Note edited")); err != nil {
+		return previous, err
+	}
+	event.Publish(model.NOTE_UPDATED, model.NoteEvent{Note: note, Previous: &previous})
+	return note, nil
+}
+
+// DeleteNoteById deletes a note together with the replies below it
+func (s *noteService) DeleteNoteById(id uint64) error {
+	note := s.repo.GetNoteById(id)
+	if note.ID == 0 {
+		return errors.New("note not found")
+	}
+	ids := []uint64{note.ID}
+	for i := 0; i < len(ids); i++ {
+		for _, reply := range s.repo.GetReplies(ids[i]) {
+			ids = append(ids, reply.ID)
+		}
+	}
+	if err := s.repo.DeleteNotesByIds(ids, s.itemHistory(note, "Note deleted")); err != nil {
+		return err
+	}
+	event.Publish(model.NOTE_DELETED, model.NoteEvent{Note: note})
+	return nil
+}
+
+// itemHistory records a change to a note on an item in the item's status
+// history, which keeps the status as it is. Notes on users have no history
+// of their own; their earlier texts are kept as revisions.
+func (s *noteService) itemHistory(note model.Note, action string) *lifecyclemodel.StatusHistory {
+	if note.ItemID == nil {
+		return nil
+	}
+	item := s.itemService.GetItemById(*note.ItemID)
+	if item.ID == 0 {
+		return nil
+	}
+	return &lifecyclemodel.StatusHistory{
+		ItemID:     item.ID,
+		FromStatus: item.AssetStatus,
+		ToStatus:   item.AssetStatus,
+		Note:       action + ": " + note.Body,
+		ChangedAt:  time.Now(),
+	}
+}
+
+// Threads arranges notes, oldest first, into threads. A reply whose note is
+// not among them starts a thread of its own.
+func Threads(notes []model.Note) []model.NoteThread {
+	present := map[uint64]bool{}
+	for _, note := range notes {
+		present[note.ID] = true
+	}
+	replies := map[uint64][]model.Note{}
+	var roots []model.Note
+	for _, note := range notes {
+		if note.ReplyToID != nil && present[*note.ReplyToID] {
+			replies[*note.ReplyToID] = append(replies[*note.ReplyToID], note)
+		} else {
+			roots = append(roots, note)
+		}
+	}
+	var build func(note model.Note) model.NoteThread
+	build = func(note model.Note) model.NoteThread {
+		thread := model.NoteThread{Note: note, Replies: []model.NoteThread{}}
+		for _, reply := range replies[note.ID] {
+			thread.Replies = append(thread.Replies, build(reply))
+		}
+		return thread
+	}
+	threads := make([]model.NoteThread, 0, len(roots))
+	for _, root := range roots {
+		threads = append(threads, build(root))
+	}
+	return threads
+}
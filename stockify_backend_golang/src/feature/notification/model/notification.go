@@ -0,0 +1,30 @@
+package model
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	EXPIRING NotificationType = "Expiring"
+	EXPIRED  NotificationType = "Expired"
+)
+
+// ExpiredThresholdDays is the threshold recorded for notifications about items
+// whose warranty has already run out.
+const ExpiredThresholdDays = 0
+
+// Notification is computed on demand from items, rules and states; it is not
+// stored.
+type Notification struct {
+	ItemID        uint64               `json:"ItemID"`
+	AssetNo       string               `json:"AssetNo"`
+	DeviceType    itemmodel.DeviceType `json:"DeviceType"`
+	WarrantyDate  time.Time            `json:"WarrantyDate"`
+	DaysRemaining int                  `json:"DaysRemaining"`
+	ThresholdDays int                  `json:"ThresholdDays"`
+	Type          NotificationType     `json:"Type"`
+	Message       string               `json:"Message"`
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+
+	"gorm.io/gorm"
+)
+
+// NotificationRule raises a warranty notification once an item is within
+// ThresholdDays of its warranty date. A rule without a DeviceType applies to
+// every device type that has no rules of its own. A rule with a
+// SavedSearchID applies to the items that search matches, and those items
+// follow only such rules.
+type NotificationRule struct {
+	gorm.Model
+	ID            uint64                `gorm:"primaryKey;autoIncrement" json:"ID"`
+	DeviceType    *itemmodel.DeviceType `json:"DeviceType,omitempty"`
+	SavedSearchID *uint64               `gorm:"index" json:"SavedSearchID,omitempty"`
+	ThresholdDays int                   `json:"ThresholdDays"`
+	Enabled       bool                  `json:"Enabled"`
+}
+
+// DefaultThresholdDays are seeded as global rules on first start.
+var DefaultThresholdDays = []int{90, 30, 7}
+
+func (r *NotificationRule) String() string {
+	deviceType := "All"
+	if r.DeviceType != nil {
+		deviceType = string(*r.DeviceType)
+	}
+	if r.SavedSearchID != nil {
+		deviceType = fmt.Sprintf("SavedSearch %d", *r.SavedSearchID)
+	}
+	return fmt.Sprintf("NotificationRule{ID: %d, DeviceType: %s, ThresholdDays: %d, Enabled: %t}",
+		r.ID, deviceType, r.ThresholdDays, r.Enabled)
+}
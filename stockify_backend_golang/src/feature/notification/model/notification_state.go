@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotificationStatus string
+
+const (
+	DISMISSED NotificationStatus = "Dismissed"
+	SNOOZED   NotificationStatus = "Snoozed"
+)
+
+// NotificationState records what the user did with the notification raised
+// for an item at a given threshold. A new threshold raises a new notification.
+type NotificationState struct {
+	gorm.Model
+	ID            uint64             `gorm:"primaryKey;autoIncrement" json:"ID"`
+	ItemID        uint64             `gorm:"uniqueIndex:idx_notification_state_item_threshold" json:"ItemID"`
+	ThresholdDays int                `gorm:"uniqueIndex:idx_notification_state_item_threshold" json:"ThresholdDays"`
+	Status        NotificationStatus `json:"Status"`
+	SnoozedUntil  *time.Time         `json:"SnoozedUntil,omitempty"`
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/notification/model"
+)
+
+type NotificationRepository interface {
+	GetAllRules() []model.NotificationRule
+	GetRuleById(id uint64) model.NotificationRule
+	AddRule(rule model.NotificationRule)
+	UpdateRule(rule model.NotificationRule)
+	DeleteRuleById(id uint64)
+	GetAllStates() []model.NotificationState
+	SaveState(state model.NotificationState) error
+}
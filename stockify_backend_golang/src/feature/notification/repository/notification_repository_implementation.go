@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/notification/model"
+
+	"gorm.io/gorm/clause"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.NotificationRule{}, &model.NotificationState{})
+	if err != nil {
+		log.Fatal("Failed to migrate Notification tables: " + err.Error())
+	}
+	seedDefaultRules()
+}
+
+// seedDefaultRules installs the global thresholds the first time the table is
+// created so that notifications work out of the box.
+func seedDefaultRules() {
+	var count int64
+	db.DB.Unscoped().Model(&model.NotificationRule{}).Count(&count)
+	if count > 0 {
+		return
+	}
+	for _, days := range model.DefaultThresholdDays {
+		db.DB.Create(&model.NotificationRule{ThresholdDays: days, Enabled: true})
+	}
+}
+
+type notificationRepository struct{}
+
+func NotificationRepositoryImplementation() NotificationRepository {
+	return &notificationRepository{}
+}
+
+func (r *notificationRepository) GetAllRules() []model.NotificationRule {
+	var rules []model.NotificationRule
+	db.DB.Order("threshold_days DESC").Find(&rules)
+	return rules
+}
+
+func (r *notificationRepository) GetRuleById(id uint64) model.NotificationRule {
+	var rule model.NotificationRule
+	db.DB.First(&rule, id)
+	return rule
+}
+
+func (r *notificationRepository) AddRule(rule model.NotificationRule) {
+	db.DB.Create(&rule)
+}
+
+func (r *notificationRepository) UpdateRule(rule model.NotificationRule) {
+	db.DB.Save(&rule)
+}
+
+func (r *notificationRepository) DeleteRuleById(id uint64) {
+	db.DB.Delete(&model.NotificationRule{}, id)
+}
+
+func (r *notificationRepository) GetAllStates() []model.NotificationState {
+	var states []model.NotificationState
+	db.DB.Find(&states)
+	return states
+}
+
+func (r *notificationRepository) SaveState(state model.NotificationState) error {
+	return db.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "threshold_days"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "snoozed_until", "updated_at", "deleted_at"}),
+	}).Create(&state).Error
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/notification/model"
+	"time"
+)
+
+type NotificationService interface {
+	GetPendingNotifications(now time.Time) []model.Notification
+	GetAllRules() []model.NotificationRule
+	GetRuleById(id uint64) model.NotificationRule
+	AddRule(rule model.NotificationRule) error
+	UpdateRule(rule model.NotificationRule) error
+	DeleteRuleById(id uint64)
+	DismissNotification(itemId uint64, thresholdDays int) error
+	SnoozeNotification(itemId uint64, thresholdDays int, until time.Time) error
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"stockify_backend_golang/src/common/timeutil"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemrepository "stockify_backend_golang/src/feature/item/repository"
+	"stockify_backend_golang/src/feature/notification/model"
+	"stockify_backend_golang/src/feature/notification/repository"
+	savedsearchrepository "stockify_backend_golang/src/feature/savedsearch/repository"
+	"time"
+)
+
+type notificationService struct {
+	repo     repository.NotificationRepository
+	itemRepo itemrepository.ItemRepository
+}
+
+func NotificationServiceImplementation(
+	repo repository.NotificationRepository,
+	itemRepo itemrepository.ItemRepository,
+) NotificationService {
+	return &notificationService{repo: repo, itemRepo: itemRepo}
+}
+
+func (s *notificationService) GetPendingNotifications(now time.Time) []model.Notification {
+	rules := s.repo.GetAllRules()
+	return EvaluateNotifications(s.itemRepo.GetAllItems(), rules, s.searchMatches(rules), s.repo.GetAllStates(), now)
+}
+
+// searchMatches runs the saved searches of the enabled rules and returns the
+// ids of the items each one matches, by saved search id. A rule whose saved
+// search is gone matches nothing.
+func (s *notificationService) searchMatches(rules []model.NotificationRule) map[uint64]map[uint64]bool {
+	matches := map[uint64]map[uint64]bool{}
+	for _, rule := range rules {
+		if !rule.Enabled || rule.SavedSearchID == nil || matches[*rule.SavedSearchID] != nil {
+			continue
+		}
+		matched := map[uint64]bool{}
+		items, err := s.itemRepo.GetFilteredItems(itemmodel.ItemFilterParams{SavedSearchID: rule.SavedSearchID})
+		if err != nil {
+			log.Println("Failed to run saved search of notification rule", rule.ID, ":", err)
+		}
+		for _, item := range items {
+			matched[item.ID] = true
+		}
+		matches[*rule.SavedSearchID] = matched
+	}
+	return matches
+}
+
+func (s *notificationService) GetAllRules() []model.NotificationRule {
+	return s.repo.GetAllRules()
+}
+
+func (s *notificationService) GetRuleById(id uint64) model.NotificationRule {
+	return s.repo.GetRuleById(id)
+}
+
+func (s *notificationService) AddRule(rule model.NotificationRule) error {
+	if err := checkRule(rule); err != nil {
+		return err
+	}
+	s.repo.AddRule(rule)
+	return nil
+}
+
+func (s *notificationService) UpdateRule(rule model.NotificationRule) error {
+	if err := checkRule(rule); err != nil {
+		return err
+	}
+	if s.repo.GetRuleById(rule.ID).ID == 0 {
+		return errors.New("notification rule not found")
+	}
+	s.repo.UpdateRule(rule)
+	return nil
+}
+
+func checkRule(rule model.NotificationRule) error {
+	if rule.ThresholdDays <= 0 {
+		return errors.New("threshold days must be greater than zero")
+	}
+	if rule.SavedSearchID != nil {
+		if _, ok := savedsearchrepository.SavedFilter(*rule.SavedSearchID); !ok {
+			return errors.New("saved search not found")
+		}
+	}
+	return nil
+}
+
+func (s *notificationService) DeleteRuleById(id uint64) {
+	s.repo.DeleteRuleById(id)
+}
+
+func (s *notificationService) DismissNotification(itemId uint64, thresholdDays int) error {
+	return s.repo.SaveState(model.NotificationState{
+		ItemID:        itemId,
+		ThresholdDays: thresholdDays,
+		Status:        model.DISMISSED,
+	})
+}
+
+func (s *notificationService) SnoozeNotification(itemId uint64, thresholdDays int, until time.Time) error {
+	return s.repo.SaveState(model.NotificationState{
+		ItemID:        itemId,
+		ThresholdDays: thresholdDays,
+		Status:        model.SNOOZED,
+		SnoozedUntil:  &until,
+	})
+}
+
+// EvaluateNotifications works out which notifications are pending at now.
+// Each item raises at most one notification: the tightest threshold it has
+// crossed, or an expired notification once the warranty date has passed.
+// Rules scoped to a device type replace the global rules for that type, and
+// rules scoped to a saved search replace both for the items in searchMatches
+// under the search's id.
+func EvaluateNotifications(
+	items []itemmodel.Item,
+	rules []model.NotificationRule,
+	searchMatches map[uint64]map[uint64]bool,
+	states []model.NotificationState,
+	now time.Time,
+) []model.Notification {
+	var globalThresholds []int
+	typeThresholds := map[itemmodel.DeviceType][]int{}
+	searchThresholds := map[uint64][]int{}
+	for _, rule := range rules {
+		if !rule.Enabled || rule.ThresholdDays <= 0 {
+			continue
+		}
+		if rule.SavedSearchID != nil {
+			searchThresholds[*rule.SavedSearchID] = append(searchThresholds[*rule.SavedSearchID], rule.ThresholdDays)
+		} else if rule.DeviceType == nil {
+			globalThresholds = append(globalThresholds, rule.ThresholdDays)
+		} else {
+			typeThresholds[*rule.DeviceType] = append(typeThresholds[*rule.DeviceType], rule.ThresholdDays)
+		}
+	}
+
+	type stateKey struct {
+		itemId        uint64
+		thresholdDays int
+	}
+	stateByKey := map[stateKey]model.NotificationState{}
+	for _, state := range states {
+		stateByKey[stateKey{state.ItemID, state.ThresholdDays}] = state
+	}
+
+	notifications := []model.Notification{}
+	for _, item := range items {
+		if item.AssetStatus == itemmodel.DISPOSED || item.WarrantyDate.Unix() <= 0 {
+			continue
+		}
+		var thresholds []int
+		for searchId, searchThreshold := range searchThresholds {
+			if searchMatches[searchId][item.ID] {
+				thresholds = append(thresholds, searchThreshold...)
+			}
+		}
+		if thresholds == nil {
+			var ok bool
+			if thresholds, ok = typeThresholds[item.DeviceType]; !ok {
+				thresholds = globalThresholds
+			}
+		}
+
+		daysRemaining := timeutil.DaysBetween(now, item.WarrantyDate)
+		notificationType := model.EXPIRING
+		threshold := -1
+		if daysRemaining < 0 {
+			notificationType = model.EXPIRED
+			threshold = model.ExpiredThresholdDays
+		} else {
+			for _, t := range thresholds {
+				if daysRemaining <= t && (threshold == -1 || t < threshold) {
+					threshold = t
+				}
+			}
+		}
+		if threshold == -1 {
+			continue
+		}
+
+		if state, ok := stateByKey[stateKey{item.ID, threshold}]; ok {
+			if state.Status == model.DISMISSED {
+				continue
+			}
+			if state.Status == model.SNOOZED && state.SnoozedUntil != nil && now.Before(*state.SnoozedUntil) {
+				continue
+			}
+		}
+
+		notifications = append(notifications, model.Notification{
+			ItemID:        item.ID,
+			AssetNo:       item.AssetNo,
+			DeviceType:    item.DeviceType,
+			WarrantyDate:  item.WarrantyDate,
+			DaysRemaining: daysRemaining,
+			ThresholdDays: threshold,
+			Type:          notificationType,
+			Message:       notificationMessage(item, daysRemaining),
+		})
+	}
+
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].DaysRemaining < notifications[j].DaysRemaining
+	})
+	return notifications
+}
+
+func notificationMessage(item itemmodel.Item, daysRemaining int) string {
+	switch {
+	case daysRemaining < 0:
+		return fmt.Sprintf("Warranty for %s (%s) expired %d day(s) ago", item.AssetNo, item.DeviceType, -daysRemaining)
+	case daysRemaining == 0:
+		return fmt.Sprintf("Warranty for %s (%s) expires today", item.AssetNo, item.DeviceType)
+	default:
+		return fmt.Sprintf("Warranty for %s (%s) expires in %d day(s)", item.AssetNo, item.DeviceType, daysRemaining)
+	}
+}
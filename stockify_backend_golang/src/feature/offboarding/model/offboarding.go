@@ -0,0 +1,42 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Offboarding records a user leaving: where each of their items went and
+// who carried it out. The user is deactivated once it completes.
+type Offboarding struct {
+	gorm.Model
+	ID          uint64            `gorm:"primaryKey;autoIncrement" json:"ID"`
+	UserID      uint64            `gorm:"index" json:"UserID"`
+	UserName    string            `json:"UserName"`
+	PerformedBy string            `json:"PerformedBy,omitempty"`
+	Note        string            `json:"Note,omitempty"`
+	CompletedAt time.Time         `gorm:"index" json:"CompletedAt"`
+	Items       []OffboardingItem `gorm:"foreignKey:OffboardingID" json:"Items"`
+}
+
+func (o *Offboarding) String() string {
+	return fmt.Sprintf("Offboarding{ID: %d, UserName: %s, Items: %d, CompletedAt: %s}",
+		o.ID, o.UserName, len(o.Items), o.CompletedAt.Format(time.DateTime))
+}
+
+// OffboardingItem is one item handed on during an offboarding. A nil
+// ToUserID means the item went back to stock.
+type OffboardingItem struct {
+	ID            uint64                `gorm:"primaryKey;autoIncrement" json:"ID"`
+	OffboardingID uint64                `gorm:"index" json:"OffboardingID"`
+	ItemID        uint64                `gorm:"index" json:"ItemID"`
+	AssetNo       string                `json:"AssetNo"`
+	FromStatus    itemmodel.AssetStatus `json:"FromStatus"`
+	ToStatus      itemmodel.AssetStatus `json:"ToStatus"`
+	ToUserID      *uint64               `json:"ToUserID,omitempty"`
+	LocationID    *uint64               `json:"LocationID,omitempty"`
+	// Set for a component that followed the item it is built into
+	WithParentID *uint64 `json:"WithParentID,omitempty"`
+}
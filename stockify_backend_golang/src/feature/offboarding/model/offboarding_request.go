@@ -0,0 +1,37 @@
+package model
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	loanmodel "stockify_backend_golang/src/feature/loan/model"
+	usermodel "stockify_backend_golang/src/feature/user/model"
+)
+
+// OffboardingPlan lists everything a leaving user holds. Each of Items needs
+// an ItemDisposition; Components move with the item they are built into.
+// Checked out loans must be checked in first, reservations are cancelled.
+type OffboardingPlan struct {
+	User            usermodel.User   `json:"User"`
+	Items           []itemmodel.Item `json:"Items"`
+	Components      []itemmodel.Item `json:"Components"`
+	CheckedOutLoans []loanmodel.Loan `json:"CheckedOutLoans"`
+	Reservations    []loanmodel.Loan `json:"Reservations"`
+}
+
+// ItemDisposition says where an item of the leaving user goes. Without
+// ReassignToID it returns to stock, by default as In Stock; a reassigned item
+// keeps its status unless Status is given. Fields are the transition fields
+// the lifecycle requires for the status change.
+type ItemDisposition struct {
+	ItemID       uint64                 `json:"ItemID"`
+	ReassignToID *uint64                `json:"ReassignToID,omitempty"`
+	Status       *itemmodel.AssetStatus `json:"Status,omitempty"`
+	LocationID   *uint64                `json:"LocationID,omitempty"`
+	Fields       map[string]string      `json:"Fields,omitempty"`
+}
+
+type OffboardingRequest struct {
+	UserID      uint64            `json:"UserID"`
+	Items       []ItemDisposition `json:"Items"`
+	PerformedBy string            `json:"PerformedBy,omitempty"`
+	Note        string            `json:"Note,omitempty"`
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	"stockify_backend_golang/src/feature/offboarding/model"
+)
+
+type OffboardingRepository interface {
+	GetAllOffboardings() []model.Offboarding
+	GetOffboardingById(id uint64) model.Offboarding
+	Offboard(offboarding *model.Offboarding, items []itemmodel.Item, history []lifecyclemodel.StatusHistory, reservationIds []uint64) error
+}
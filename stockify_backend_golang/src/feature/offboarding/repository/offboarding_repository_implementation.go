@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"stockify_backend_golang/src/common/db"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	loanmodel "stockify_backend_golang/src/feature/loan/model"
+	"stockify_backend_golang/src/feature/offboarding/model"
+	usermodel "stockify_backend_golang/src/feature/user/model"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Offboarding{}, &model.OffboardingItem{})
+	if err != nil {
+		log.Fatal("Failed to migrate Offboarding table: " + err.Error())
+	}
+}
+
+type offboardingRepository struct{}
+
+func OffboardingRepositoryImplementation() OffboardingRepository {
+	return &offboardingRepository{}
+}
+
+func (r *offboardingRepository) GetAllOffboardings() []model.Offboarding {
+	var offboardings []model.Offboarding
+	db.DB.Preload("Items").Order("completed_at DESC").Find(&offboardings)
+	return offboardings
+}
+
+func (r *offboardingRepository) GetOffboardingById(id uint64) model.Offboarding {
+	var offboarding model.Offboarding
+	db.DB.Preload("Items").First(&offboarding, id)
+	return offboarding
+}
+
+// Offboard saves the new assignee, location and status of the items, their
+// status history, the cancelled reservations and the offboarding record, and
+// deletes the user, all in one transaction. Nothing is written if the user
+// would be left holding an item.
+func (r *offboardingRepository) Offboard(
+	offboarding *model.Offboarding,
+	items []itemmodel.Item,
+	history []lifecyclemodel.StatusHistory,
+	reservationIds []uint64,
+) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			err := tx.Model(&itemmodel.Item{}).Where("id = ?", item.ID).Updates(map[string]interface{}{
+				"assigned_to_id": item.AssignedToID,
+				"location_id":    item.LocationID,
+				"asset_status":   item.AssetStatus,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(history) > 0 {
+			if err := tx.Create(&history).Error; err != nil {
+				return err
+			}
+		}
+
+		var left int64
+		if err := tx.Model(&itemmodel.Item{}).Where("assigned_to_id = ?", offboarding.UserID).Count(&left).Error; err != nil {
+			return err
+		}
+		if left > 0 {
+			return fmt.Errorf("%d item(s) are still assigned to %s, the user was not deactivated", left, offboarding.UserName)
+		}
+
+		if len(reservationIds) > 0 {
+			err := tx.Model(&loanmodel.Loan{}).
+				Where("id IN ? AND status = ?", reservationIds, loanmodel.RESERVED).
+				Update("status", loanmodel.CANCELLED).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(offboarding).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&usermodel.User{}, offboarding.UserID).Error
+	})
+}
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/offboarding/model"
+)
+
+type OffboardingService interface {
+	GetOffboardingPlan(userId uint64) (model.OffboardingPlan, error)
+	Offboard(request model.OffboardingRequest) (model.Offboarding, error)
+	GetAllOffboardings() []model.Offboarding
+	GetOffboardingById(id uint64) model.Offboarding
+}
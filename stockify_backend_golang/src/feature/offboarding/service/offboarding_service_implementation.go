@@ -0,0 +1,297 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"stockify_backend_golang/src/common/event"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+	lifecycleservice "stockify_backend_golang/src/feature/lifecycle/service"
+	loanmodel "stockify_backend_golang/src/feature/loan/model"
+	loanservice "stockify_backend_golang/src/feature/loan/service"
+	"stockify_backend_golang/src/feature/offboarding/model"
+	"stockify_backend_golang/src/feature/offboarding/repository"
+	usermodel "stockify_backend_golang/src/feature/user/model"
+	userservice "stockify_backend_golang/src/feature/user/service"
+	"strings"
+	"time"
+)
+
+type offboardingService struct {
+	repo             repository.OffboardingRepository
+	itemService      itemservice.ItemService
+	userService      userservice.UserService
+	lifecycleService lifecycleservice.LifecycleService
+	loanService      loanservice.LoanService
+}
+
+func OffboardingServiceImplementation(
+	repo repository.OffboardingRepository,
+	itemService itemservice.ItemService,
+	userService userservice.UserService,
+	lifecycleService lifecycleservice.LifecycleService,
+	loanService loanservice.LoanService,
+) OffboardingService {
+	return &offboardingService{
+		repo:             repo,
+		itemService:      itemService,
+		userService:      userService,
+		lifecycleService: lifecycleService,
+		loanService:      loanService,
+	}
+}
+
+func (s *offboardingService) GetAllOffboardings() []model.Offboarding {
+	return s.repo.GetAllOffboardings()
+}
+
+func (s *offboardingService) GetOffboardingById(id uint64) model.Offboarding {
+	return s.repo.GetOffboardingById(id)
+}
+
+// GetOffboardingPlan lists the items and loans of a user that an
+// offboarding has to deal with.
+func (s *offboardingService) GetOffboardingPlan(userId uint64) (model.OffboardingPlan, error) {
+	plan := model.OffboardingPlan{
+		Items:           []itemmodel.Item{},
+		Components:      []itemmodel.Item{},
+		CheckedOutLoans: []loanmodel.Loan{},
+		Reservations:    []loanmodel.Loan{},
+	}
+	plan.User = s.userService.GetUserById(userId)
+	if plan.User.ID == 0 {
+		return plan, errors.New("user not found")
+	}
+	held, err := s.heldItems(userId)
+	if err != nil {
+		return plan, err
+	}
+	heldIds := map[uint64]bool{}
+	for _, item := range held {
+		heldIds[item.ID] = true
+	}
+	for _, item := range held {
+		if item.ParentID != nil && heldIds[*item.ParentID] {
+			plan.Components = append(plan.Components, item)
+		} else {
+			plan.Items = append(plan.Items, item)
+		}
+	}
+	loans := s.loanService.GetLoans(loanmodel.LoanQueryParams{UserID: &userId, Statuses: loanmodel.ActiveLoanStatuses})
+	for _, loan := range loans {
+		if loan.Status == loanmodel.CHECKED_OUT {
+			plan.CheckedOutLoans = append(plan.CheckedOutLoans, loan)
+		} else {
+			plan.Reservations = append(plan.Reservations, loan)
+		}
+	}
+	return plan, nil
+}
+
+// Offboard hands every item of the leaving user on as the request says,
+// cancels their reservations, records the offboarding and deactivates the
+// user. The request is checked against the plan first, and the changes are
+// then written in one transaction, so a failure leaves the user and their
+// items as they were. Every item handed on gets a status history entry,
+// also when its status stays the same, so the history shows who it went to.
+func (s *offboardingService) Offboard(request model.OffboardingRequest) (model.Offboarding, error) {
+	plan, err := s.GetOffboardingPlan(request.UserID)
+	if err != nil {
+		return model.Offboarding{}, err
+	}
+	if len(plan.CheckedOutLoans) > 0 {
+		return model.Offboarding{}, fmt.Errorf("%d loaned item(s) are still checked out to %s, check them in first",
+			len(plan.CheckedOutLoans), plan.User.UserName)
+	}
+	targets, err := s.resolve(plan, request.Items)
+	if err != nil {
+		return model.Offboarding{}, err
+	}
+
+	note := "Offboarding of " + plan.User.UserName
+	if request.Note = strings.TrimSpace(request.Note); request.Note != "" {
+		note += ": " + request.Note
+	}
+	offboarding := model.Offboarding{
+		UserID:      plan.User.ID,
+		UserName:    plan.User.UserName,
+		PerformedBy: strings.TrimSpace(request.PerformedBy),
+		Note:        request.Note,
+	}
+	now := time.Now()
+	var moved []itemmodel.Item
+	var history []lifecyclemodel.StatusHistory
+	previous := map[uint64]itemmodel.Item{}
+	for _, item := range plan.Items {
+		target := targets[item.ID]
+		previous[item.ID] = item
+		fields := target.disposition.Fields
+		if target.status == item.AssetStatus {
+			fields = nil
+		}
+		item.AssignedToID = target.disposition.ReassignToID
+		item.AssignedTo = nil
+		if target.disposition.LocationID != nil {
+			item.LocationID = target.disposition.LocationID
+		}
+		item.AssetStatus = target.status
+		moved = append(moved, item)
+		history = append(history, s.handOverHistory(previous[item.ID], item, fields, note, now))
+		offboarding.Items = append(offboarding.Items, model.OffboardingItem{
+			ItemID:     item.ID,
+			AssetNo:    item.AssetNo,
+			FromStatus: previous[item.ID].AssetStatus,
+			ToStatus:   item.AssetStatus,
+			ToUserID:   item.AssignedToID,
+			LocationID: item.LocationID,
+		})
+	}
+	// Components follow the item they are built into to its new assignee
+	for _, component := range plan.Components {
+		previous[component.ID] = component
+		component.AssignedToID = targets[topLevelParent(plan, component)].disposition.ReassignToID
+		component.AssignedTo = nil
+		moved = append(moved, component)
+		history = append(history, s.handOverHistory(previous[component.ID], component, nil, note, now))
+		offboarding.Items = append(offboarding.Items, model.OffboardingItem{
+			ItemID:       component.ID,
+			AssetNo:      component.AssetNo,
+			FromStatus:   component.AssetStatus,
+			ToStatus:     component.AssetStatus,
+			ToUserID:     component.AssignedToID,
+			LocationID:   component.LocationID,
+			WithParentID: component.ParentID,
+		})
+	}
+	var reservationIds []uint64
+	for _, reservation := range plan.Reservations {
+		reservationIds = append(reservationIds, reservation.ID)
+	}
+
+	offboarding.CompletedAt = now
+	if err := s.repo.Offboard(&offboarding, moved, history, reservationIds); err != nil {
+		return model.Offboarding{}, err
+	}
+
+	// Tell the rest of the app what changed, now that it is committed
+	for _, item := range moved {
+		before := previous[item.ID]
+		payload := itemmodel.ItemEvent{Item: s.itemService.GetItemById(item.ID), Previous: &before}
+		event.Publish(itemmodel.ITEM_UPDATED, payload)
+		if item.AssetStatus == itemmodel.DISPOSED && before.AssetStatus != itemmodel.DISPOSED {
+			event.Publish(itemmodel.ITEM_DISPOSED, payload)
+		}
+		event.Publish(itemmodel.ITEM_ASSIGNEE_CHANGED, payload)
+	}
+	for _, reservation := range plan.Reservations {
+		reservation.Status = loanmodel.CANCELLED
+		event.Publish(loanmodel.LOAN_CANCELLED, loanmodel.LoanEvent{Loan: reservation})
+	}
+	event.Publish(usermodel.USER_DELETED, usermodel.UserEvent{User: plan.User})
+	return offboarding, nil
+}
+
+// handOverHistory describes the hand over of one item as a status history
+// entry, naming the user it went to.
+func (s *offboardingService) handOverHistory(
+	before, after itemmodel.Item,
+	fields map[string]string,
+	note string,
+	at time.Time,
+) lifecyclemodel.StatusHistory {
+	handedTo := "unassigned"
+	if after.AssignedToID != nil {
+		handedTo = "handed to " + s.userService.GetUserById(*after.AssignedToID).UserName
+	}
+	return lifecyclemodel.StatusHistory{
+		ItemID:     after.ID,
+		FromStatus: before.AssetStatus,
+		ToStatus:   after.AssetStatus,
+		Fields:     fields,
+		Note:       note + " (" + handedTo + ")",
+		ChangedAt:  at,
+	}
+}
+
+// topLevelParent walks up from a component to the item of the plan it is
+// built into.
+func topLevelParent(plan model.OffboardingPlan, component itemmodel.Item) uint64 {
+	components := map[uint64]itemmodel.Item{}
+	for _, c := range plan.Components {
+		components[c.ID] = c
+	}
+	id := *component.ParentID
+	for {
+		parent, ok := components[id]
+		if !ok {
+			return id
+		}
+		id = *parent.ParentID
+	}
+}
+
+type itemTarget struct {
+	disposition model.ItemDisposition
+	status      itemmodel.AssetStatus
+}
+
+// resolve checks that every item of the plan has exactly one valid
+// disposition and works out the status each item ends up with.
+func (s *offboardingService) resolve(plan model.OffboardingPlan, dispositions []model.ItemDisposition) (map[uint64]itemTarget, error) {
+	items := map[uint64]itemmodel.Item{}
+	for _, item := range plan.Items {
+		items[item.ID] = item
+	}
+	components := map[uint64]bool{}
+	for _, component := range plan.Components {
+		components[component.ID] = true
+	}
+
+	targets := map[uint64]itemTarget{}
+	for _, disposition := range dispositions {
+		item, ok := items[disposition.ItemID]
+		switch {
+		case components[disposition.ItemID]:
+			return nil, fmt.Errorf("item %d moves with the item it is built into", disposition.ItemID)
+		case !ok:
+			return nil, fmt.Errorf("item %d is not assigned to %s", disposition.ItemID, plan.User.UserName)
+		}
+		if _, seen := targets[item.ID]; seen {
+			return nil, fmt.Errorf("item %s is given more than once", item.AssetNo)
+		}
+		if to := disposition.ReassignToID; to != nil {
+			if *to == plan.User.ID {
+				return nil, fmt.Errorf("item %s cannot be reassigned to the leaving user", item.AssetNo)
+			}
+			if s.userService.GetUserById(*to).ID == 0 {
+				return nil, fmt.Errorf("user %d to reassign item %s to not found", *to, item.AssetNo)
+			}
+		}
+		status := item.AssetStatus
+		if disposition.Status != nil {
+			status = *disposition.Status
+		} else if disposition.ReassignToID == nil {
+			status = itemmodel.IN_STOCK
+		}
+		if err := s.lifecycleService.CheckTransition(item.AssetStatus, status, disposition.Fields); err != nil {
+			return nil, fmt.Errorf("item %s: %w", item.AssetNo, err)
+		}
+		targets[item.ID] = itemTarget{disposition: disposition, status: status}
+	}
+
+	var missing []string
+	for _, item := range plan.Items {
+		if _, ok := targets[item.ID]; !ok {
+			missing = append(missing, item.AssetNo)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no reassignment or return given for %s", strings.Join(missing, ", "))
+	}
+	return targets, nil
+}
+
+func (s *offboardingService) heldItems(userId uint64) ([]itemmodel.Item, error) {
+	return s.itemService.GetFilteredItems(itemmodel.ItemFilterParams{AssignedToID: &userId, SortBy: "asset_no"})
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// OrgUnit is a department or other unit of the organization. Units nest to
+// any depth; Code is the cost center equipment is billed to.
+type OrgUnit struct {
+	gorm.Model
+	ID       uint64  `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name     string  `json:"Name"`
+	Code     *string `gorm:"uniqueIndex" json:"Code,omitempty"`
+	ParentID *uint64 `gorm:"index" json:"ParentID,omitempty"`
+}
+
+func (u *OrgUnit) String() string {
+	return fmt.Sprintf("OrgUnit{ID: %d, Name: %s}", u.ID, u.Name)
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"slices"
+	"strings"
+)
+
+// OrgUnitNode is an org unit with its children and the users and items it
+// accounts for. Items count towards the unit of the user holding them. The
+// direct figures cover the unit itself, the totals include every unit below.
+type OrgUnitNode struct {
+	OrgUnit
+	Path            string          `json:"Path"`
+	UserCount       int64           `json:"UserCount"`
+	TotalUserCount  int64           `json:"TotalUserCount"`
+	ItemCount       int64           `json:"ItemCount"`
+	TotalItemCount  int64           `json:"TotalItemCount"`
+	ItemValues      []CurrencyValue `json:"ItemValues"`
+	TotalItemValues []CurrencyValue `json:"TotalItemValues"`
+	Children        []OrgUnitNode   `json:"Children"`
+}
+
+// CurrencyValue is the purchase price of items bought in one currency.
+// Amounts are never converted, so items bought in several currencies add up
+// to one value for each.
+type CurrencyValue struct {
+	Currency string  `json:"Currency"`
+	Value    float64 `json:"Value"`
+}
+
+// AddValues adds the amounts to the values of the same currency, keeping the
+// result ordered by currency
+func AddValues(values []CurrencyValue, amounts ...CurrencyValue) []CurrencyValue {
+	for _, amount := range amounts {
+		index, found := slices.BinarySearchFunc(values, amount.Currency, func(value CurrencyValue, currency string) int {
+			return strings.Compare(value.Currency, currency)
+		})
+		if found {
+			values[index].Value += amount.Value
+		} else {
+			values = slices.Insert(values, index, amount)
+		}
+	}
+	return values
+}
+
+// OrgUnitItemTotals are the items held by the users of one unit
+type OrgUnitItemTotals struct {
+	OrgUnitID uint64
+	Count     int64
+	Values    []CurrencyValue
+}
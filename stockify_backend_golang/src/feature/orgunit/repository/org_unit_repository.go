@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/orgunit/model"
+)
+
+type OrgUnitRepository interface {
+	GetAllOrgUnits() []model.OrgUnit
+	GetOrgUnitById(id uint64) model.OrgUnit
+	GetOrgUnitByCode(code string) model.OrgUnit
+	AddOrgUnit(unit model.OrgUnit) error
+	UpdateOrgUnit(unit model.OrgUnit) error
+	DeleteOrgUnitById(id uint64)
+	CountChildren(id uint64) int64
+	CountUsers(id uint64) int64
+	CountUsersByOrgUnit() (map[uint64]int64, error)
+	SumItemsByOrgUnit() (map[uint64]model.OrgUnitItemTotals, error)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/orgunit/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.OrgUnit{})
+	if err != nil {
+		log.Fatal("Failed to migrate OrgUnit table: " + err.Error())
+	}
+}
+
+// SubtreeQuery selects the id of an org unit and of every unit below it; the
+// single argument is the root unit id.
+const SubtreeQuery = `WITH RECURSIVE subtree(id) AS (
+	SELECT ?
+	UNION ALL
+	SELECT org_units.id FROM org_units JOIN subtree ON org_units.parent_id = subtree.id
+	WHERE org_units.deleted_at IS NULL
+) SELECT id FROM subtree`
+
+// HolderQuery selects the users belonging to an org unit or any unit below
+// it; the single argument is the root unit id.
+const HolderQuery = "SELECT id FROM users WHERE deleted_at IS NULL AND org_unit_id IN (" + SubtreeQuery + ")"
+
+type orgUnitRepository struct{}
+
+func OrgUnitRepositoryImplementation() OrgUnitRepository {
+	return &orgUnitRepository{}
+}
+
+func (r *orgUnitRepository) GetAllOrgUnits() []model.OrgUnit {
+	var units []model.OrgUnit
+	db.DB.Order("name").Find(&units)
+	return units
+}
+
+func (r *orgUnitRepository) GetOrgUnitById(id uint64) model.OrgUnit {
+	var unit model.OrgUnit
+	db.DB.First(&unit, id)
+	return unit
+}
+
+func (r *orgUnitRepository) GetOrgUnitByCode(code string) model.OrgUnit {
+	var unit model.OrgUnit
+	db.DB.Where("code = ?", code).First(&unit)
+	return unit
+}
+
+func (r *orgUnitRepository) AddOrgUnit(unit model.OrgUnit) error {
+	return db.DB.Create(&unit).Error
+}
+
+func (r *orgUnitRepository) UpdateOrgUnit(unit model.OrgUnit) error {
+	return db.DB.Save(&unit).Error
+}
+
+func (r *orgUnitRepository) DeleteOrgUnitById(id uint64) {
+	db.HardDelete(&model.OrgUnit{}, id)
+}
+
+func (r *orgUnitRepository) CountChildren(id uint64) int64 {
+	var count int64
+	db.DB.Model(&model.OrgUnit{}).Where("parent_id = ?", id).Count(&count)
+	return count
+}
+
+func (r *orgUnitRepository) CountUsers(id uint64) int64 {
+	var count int64
+	db.DB.Table("users").Where("org_unit_id = ? AND deleted_at IS NULL", id).Count(&count)
+	return count
+}
+
+func (r *orgUnitRepository) CountUsersByOrgUnit() (map[uint64]int64, error) {
+	var rows []struct {
+		OrgUnitID uint64
+		Count     int64
+	}
+	err := db.DB.Table("users").
+		Select("org_unit_id, COUNT(*) AS count").
+		Where("org_unit_id IS NOT NULL AND deleted_at IS NULL").
+		Group("org_unit_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[uint64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.OrgUnitID] = row.Count
+	}
+	return counts, nil
+}
+
+// SumItemsByOrgUnit counts the items held by each unit's users and adds up
+// their purchase price by currency, the item's own or else its purchase
+// order's, the same way the spend report does. Items without a price count
+// with no value.
+func (r *orgUnitRepository) SumItemsByOrgUnit() (map[uint64]model.OrgUnitItemTotals, error) {
+	var rows []struct {
+		OrgUnitID uint64
+		Currency  string
+		Count     int64
+		Value     *float64
+	}
+	err := db.DB.Table("items").
+		Select("users.org_unit_id AS org_unit_id, COALESCE(items.currency, purchase_orders.currency, '') AS currency, " +
+			"COUNT(*) AS count, SUM(items.purchase_price) AS value").
+		Joins("JOIN users ON users.id = items.assigned_to_id AND users.deleted_at IS NULL").
+		Joins("LEFT JOIN purchase_orders ON purchase_orders.id = items.purchase_order_id").
+		Where("items.deleted_at IS NULL AND users.org_unit_id IS NOT NULL").
+		Group("1, 2").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	totals := make(map[uint64]model.OrgUnitItemTotals, len(rows))
+	for _, row := range rows {
+		total := totals[row.OrgUnitID]
+		total.OrgUnitID = row.OrgUnitID
+		total.Count += row.Count
+		if row.Value != nil {
+			total.Values = model.AddValues(total.Values, model.CurrencyValue{Currency: row.Currency, Value: *row.Value})
+		}
+		totals[row.OrgUnitID] = total
+	}
+	return totals, nil
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/orgunit/model"
+)
+
+type OrgUnitService interface {
+	GetAllOrgUnits() []model.OrgUnit
+	GetOrgUnitById(id uint64) model.OrgUnit
+	GetOrgUnitTree() ([]model.OrgUnitNode, error)
+	AddOrgUnit(unit model.OrgUnit) error
+	UpdateOrgUnit(unit model.OrgUnit) error
+	DeleteOrgUnitById(id uint64) error
+	SetUserOrgUnit(userId uint64, orgUnitId *uint64) error
+}
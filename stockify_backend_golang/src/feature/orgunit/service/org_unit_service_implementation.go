@@ -0,0 +1,147 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"stockify_backend_golang/src/feature/orgunit/model"
+	"stockify_backend_golang/src/feature/orgunit/repository"
+	userservice "stockify_backend_golang/src/feature/user/service"
+	"strings"
+)
+
+type orgUnitService struct {
+	repo        repository.OrgUnitRepository
+	userService userservice.UserService
+}
+
+func OrgUnitServiceImplementation(repo repository.OrgUnitRepository, userService userservice.UserService) OrgUnitService {
+	return &orgUnitService{repo: repo, userService: userService}
+}
+
+func (s *orgUnitService) GetAllOrgUnits() []model.OrgUnit {
+	return s.repo.GetAllOrgUnits()
+}
+
+func (s *orgUnitService) GetOrgUnitById(id uint64) model.OrgUnit {
+	return s.repo.GetOrgUnitById(id)
+}
+
+// GetOrgUnitTree returns the top-level units with everything below them, each
+// node carrying its own user and item figures and the totals of its subtree.
+func (s *orgUnitService) GetOrgUnitTree() ([]model.OrgUnitNode, error) {
+	userCounts, err := s.repo.CountUsersByOrgUnit()
+	if err != nil {
+		return nil, err
+	}
+	itemTotals, err := s.repo.SumItemsByOrgUnit()
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uint64][]model.OrgUnit)
+	var roots []model.OrgUnit
+	for _, unit := range s.repo.GetAllOrgUnits() {
+		if unit.ParentID == nil {
+			roots = append(roots, unit)
+		} else {
+			children[*unit.ParentID] = append(children[*unit.ParentID], unit)
+		}
+	}
+	var build func(unit model.OrgUnit, parentPath string) model.OrgUnitNode
+	build = func(unit model.OrgUnit, parentPath string) model.OrgUnitNode {
+		totals := itemTotals[unit.ID]
+		node := model.OrgUnitNode{
+			OrgUnit:    unit,
+			Path:       unit.Name,
+			UserCount:  userCounts[unit.ID],
+			ItemCount:  totals.Count,
+			ItemValues: model.AddValues([]model.CurrencyValue{}, totals.Values...),
+			Children:   []model.OrgUnitNode{},
+		}
+		if parentPath != "" {
+			node.Path = parentPath + " / " + unit.Name
+		}
+		node.TotalUserCount = node.UserCount
+		node.TotalItemCount = node.ItemCount
+		node.TotalItemValues = model.AddValues([]model.CurrencyValue{}, node.ItemValues...)
+		for _, child := range children[unit.ID] {
+			childNode := build(child, node.Path)
+			node.TotalUserCount += childNode.TotalUserCount
+			node.TotalItemCount += childNode.TotalItemCount
+			node.TotalItemValues = model.AddValues(node.TotalItemValues, childNode.TotalItemValues...)
+			node.Children = append(node.Children, childNode)
+		}
+		return node
+	}
+	tree := []model.OrgUnitNode{}
+	for _, root := range roots {
+		tree = append(tree, build(root, ""))
+	}
+	return tree, nil
+}
+
+func (s *orgUnitService) AddOrgUnit(unit model.OrgUnit) error {
+	if err := s.validateOrgUnit(unit); err != nil {
+		return err
+	}
+	return s.repo.AddOrgUnit(unit)
+}
+
+func (s *orgUnitService) UpdateOrgUnit(unit model.OrgUnit) error {
+	existing := s.repo.GetOrgUnitById(unit.ID)
+	if existing.ID == 0 {
+		return errors.New("org unit not found")
+	}
+	if err := s.validateOrgUnit(unit); err != nil {
+		return err
+	}
+	// Moving a unit below one of its own descendants would detach the branch
+	for parentId := unit.ParentID; parentId != nil; {
+		if *parentId == unit.ID {
+			return errors.New("an org unit cannot be placed inside itself")
+		}
+		parentId = s.repo.GetOrgUnitById(*parentId).ParentID
+	}
+	unit.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateOrgUnit(unit)
+}
+
+func (s *orgUnitService) DeleteOrgUnitById(id uint64) error {
+	if count := s.repo.CountChildren(id); count > 0 {
+		return fmt.Errorf("org unit contains %d other unit(s)", count)
+	}
+	if count := s.repo.CountUsers(id); count > 0 {
+		return fmt.Errorf("org unit has %d user(s)", count)
+	}
+	s.repo.DeleteOrgUnitById(id)
+	return nil
+}
+
+// SetUserOrgUnit moves the user through the user service, which publishes
+// the update like any other edit.
+func (s *orgUnitService) SetUserOrgUnit(userId uint64, orgUnitId *uint64) error {
+	user := s.userService.GetUserById(userId)
+	if user.ID == 0 {
+		return errors.New("user not found")
+	}
+	if orgUnitId != nil && s.repo.GetOrgUnitById(*orgUnitId).ID == 0 {
+		return errors.New("org unit not found")
+	}
+	user.OrgUnitID = orgUnitId
+	s.userService.UpdateUser(user)
+	return nil
+}
+
+func (s *orgUnitService) validateOrgUnit(unit model.OrgUnit) error {
+	if strings.TrimSpace(unit.Name) == "" {
+		return errors.New("org unit name is required")
+	}
+	if unit.Code != nil {
+		if other := s.repo.GetOrgUnitByCode(*unit.Code); other.ID != 0 && other.ID != unit.ID {
+			return fmt.Errorf("cost center %q is already used by %s", *unit.Code, other.Name)
+		}
+	}
+	if unit.ParentID != nil && s.repo.GetOrgUnitById(*unit.ParentID).ID == 0 {
+		return errors.New("parent org unit not found")
+	}
+	return nil
+}
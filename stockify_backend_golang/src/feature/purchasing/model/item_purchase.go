@@ -0,0 +1,11 @@
+package model
+
+// ItemPurchase is the purchase information recorded on an item. Zero ids and
+// empty strings clear the corresponding field.
+type ItemPurchase struct {
+	VendorID        uint64   `json:"VendorID"`
+	PurchaseOrderID uint64   `json:"PurchaseOrderID"`
+	PurchasePrice   *float64 `json:"PurchasePrice"`
+	Currency        string   `json:"Currency"`
+	InvoiceNo       string   `json:"InvoiceNo"`
+}
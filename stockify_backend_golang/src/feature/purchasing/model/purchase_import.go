@@ -0,0 +1,15 @@
+package model
+
+// PurchaseImportResult reports what an import of purchase data did. Lines are
+// numbered from 1 including the header.
+type PurchaseImportResult struct {
+	ItemsUpdated   int                   `json:"ItemsUpdated"`
+	VendorsCreated int                   `json:"VendorsCreated"`
+	OrdersCreated  int                   `json:"OrdersCreated"`
+	Errors         []PurchaseImportError `json:"Errors"`
+}
+
+type PurchaseImportError struct {
+	Line    int    `json:"Line"`
+	Message string `json:"Message"`
+}
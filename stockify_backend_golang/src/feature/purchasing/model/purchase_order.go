@@ -0,0 +1,25 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PurchaseOrder groups the items bought from a vendor in one order. Items
+// without a currency of their own take the order's.
+type PurchaseOrder struct {
+	gorm.Model
+	ID        uint64     `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Number    string     `gorm:"uniqueIndex" json:"Number"`
+	VendorID  uint64     `gorm:"index" json:"VendorID"`
+	Vendor    *Vendor    `gorm:"foreignKey:VendorID" json:"Vendor,omitempty"`
+	OrderDate *time.Time `json:"OrderDate,omitempty"`
+	Currency  string     `json:"Currency"`
+	Notes     *string    `json:"Notes,omitempty"`
+}
+
+func (o *PurchaseOrder) String() string {
+	return fmt.Sprintf("PurchaseOrder{ID: %d, Number: %s, VendorID: %d}", o.ID, o.Number, o.VendorID)
+}
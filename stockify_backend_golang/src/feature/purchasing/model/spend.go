@@ -0,0 +1,19 @@
+package model
+
+type SpendGrouping string
+
+const (
+	BY_VENDOR      SpendGrouping = "vendor"
+	BY_DEVICE_TYPE SpendGrouping = "device_type"
+	BY_YEAR        SpendGrouping = "year"
+)
+
+// SpendRow is the total paid for the items of one group. Amounts are never
+// converted, so a group bought in several currencies has one row for each.
+type SpendRow struct {
+	Key       string  `json:"Key"`
+	Label     string  `json:"Label"`
+	Currency  string  `json:"Currency"`
+	ItemCount int64   `json:"ItemCount"`
+	Total     float64 `json:"Total"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type Vendor struct {
+	gorm.Model
+	ID          uint64  `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name        string  `gorm:"uniqueIndex" json:"Name"`
+	ContactName *string `json:"ContactName,omitempty"`
+	Email       *string `json:"Email,omitempty"`
+	Phone       *string `json:"Phone,omitempty"`
+	Website     *string `json:"Website,omitempty"`
+	Notes       *string `json:"Notes,omitempty"`
+}
+
+func (v *Vendor) String() string {
+	return fmt.Sprintf("Vendor{ID: %d, Name: %s}", v.ID, v.Name)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/purchasing/model"
+)
+
+type PurchasingRepository interface {
+	GetAllVendors() []model.Vendor
+	GetVendorById(id uint64) model.Vendor
+	GetVendorByName(name string) model.Vendor
+	AddVendor(vendor *model.Vendor) error
+	UpdateVendor(vendor model.Vendor) error
+	DeleteVendorById(id uint64)
+	CountVendorReferences(id uint64) int64
+
+	GetAllPurchaseOrders() []model.PurchaseOrder
+	GetPurchaseOrderById(id uint64) model.PurchaseOrder
+	GetPurchaseOrderByNumber(number string) model.PurchaseOrder
+	AddPurchaseOrder(order *model.PurchaseOrder) error
+	UpdatePurchaseOrder(order model.PurchaseOrder) error
+	DeletePurchaseOrderById(id uint64)
+	CountPurchaseOrderItems(id uint64) int64
+
+	GetItemIdByAssetNo(assetNo string) uint64
+	ImportPurchase(vendor *model.Vendor, order *model.PurchaseOrder, item *itemmodel.Item) error
+	GetSpend(grouping model.SpendGrouping) ([]model.SpendRow, error)
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"stockify_backend_golang/src/common/db"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/purchasing/model"
+
+	"gorm.io/gorm"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Vendor{}, &model.PurchaseOrder{})
+	if err != nil {
+		log.Fatal("Failed to migrate Vendor/PurchaseOrder tables: " + err.Error())
+	}
+}
+
+// The key and label of each spend grouping. Items keep their own currency and
+// fall back to the one of their purchase order.
+var spendGroups = map[model.SpendGrouping]struct{ key, label, joins string }{
+	model.BY_VENDOR: {
+		key:   "COALESCE(CAST(items.vendor_id AS TEXT), '')",
+		label: "COALESCE(vendors.name, '')",
+		joins: "LEFT JOIN vendors ON vendors.id = items.vendor_id",
+	},
+	model.BY_DEVICE_TYPE: {
+		key:   "items.device_type",
+		label: "COALESCE(device_type_definitions.display_name, items.device_type)",
+		joins: "LEFT JOIN device_type_definitions ON device_type_definitions.id = items.device_type_id",
+	},
+	model.BY_YEAR: {
+		key:   "COALESCE(strftime('%Y', COALESCE(purchase_orders.order_date, items.received_date, items.created_at)), '')",
+		label: "COALESCE(strftime('%Y', COALESCE(purchase_orders.order_date, items.received_date, items.created_at)), '')",
+	},
+}
+
+type purchasingRepository struct{}
+
+func PurchasingRepositoryImplementation() PurchasingRepository {
+	return &purchasingRepository{}
+}
+
+func (r *purchasingRepository) GetAllVendors() []model.Vendor {
+	var vendors []model.Vendor
+	db.DB.Order("name").Find(&vendors)
+	return vendors
+}
+
+func (r *purchasingRepository) GetVendorById(id uint64) model.Vendor {
+	var vendor model.Vendor
+	db.DB.First(&vendor, id)
+	return vendor
+}
+
+func (r *purchasingRepository) GetVendorByName(name string) model.Vendor {
+	var vendor model.Vendor
+	db.DB.Where("LOWER(name) = LOWER(?)", name).First(&vendor)
+	return vendor
+}
+
+func (r *purchasingRepository) AddVendor(vendor *model.Vendor) error {
+	return db.DB.Create(vendor).Error
+}
+
+func (r *purchasingRepository) UpdateVendor(vendor model.Vendor) error {
+	return db.DB.Save(&vendor).Error
+}
+
+func (r *purchasingRepository) DeleteVendorById(id uint64) {
+	db.HardDelete(&model.Vendor{}, id)
+}
+
+// CountVendorReferences counts the purchase orders and items naming the vendor
+func (r *purchasingRepository) CountVendorReferences(id uint64) int64 {
+	var orders, items int64
+	db.DB.Model(&model.PurchaseOrder{}).Where("vendor_id = ?", id).Count(&orders)
+	db.DB.Table("items").Where("vendor_id = ? AND deleted_at IS NULL", id).Count(&items)
+	return orders + items
+}
+
+func (r *purchasingRepository) GetAllPurchaseOrders() []model.PurchaseOrder {
+	var orders []model.PurchaseOrder
+	db.DB.Preload("Vendor").Order("order_date DESC, number").Find(&orders)
+	return orders
+}
+
+func (r *purchasingRepository) GetPurchaseOrderById(id uint64) model.PurchaseOrder {
+	var order model.PurchaseOrder
+	db.DB.Preload("Vendor").First(&order, id)
+	return order
+}
+
+func (r *purchasingRepository) GetPurchaseOrderByNumber(number string) model.PurchaseOrder {
+	var order model.PurchaseOrder
+	db.DB.Where("number = ?", number).First(&order)
+	return order
+}
+
+func (r *purchasingRepository) AddPurchaseOrder(order *model.PurchaseOrder) error {
+	return db.DB.Omit("Vendor").Create(order).Error
+}
+
+func (r *purchasingRepository) UpdatePurchaseOrder(order model.PurchaseOrder) error {
+	return db.DB.Omit("Vendor").Save(&order).Error
+}
+
+func (r *purchasingRepository) DeletePurchaseOrderById(id uint64) {
+	db.HardDelete(&model.PurchaseOrder{}, id)
+}
+
+func (r *purchasingRepository) CountPurchaseOrderItems(id uint64) int64 {
+	var count int64
+	db.DB.Table("items").Where("purchase_order_id = ? AND deleted_at IS NULL", id).Count(&count)
+	return count
+}
+
+func (r *purchasingRepository) GetItemIdByAssetNo(assetNo string) uint64 {
+	var ids []uint64
+	db.DB.Table("items").Where("asset_no = ? AND deleted_at IS NULL", assetNo).Limit(1).Pluck("id", &ids)
+	if len(ids) == 0 {
+		return 0
+	}
+	return ids[0]
+}
+
+// ImportPurchase saves one imported row in a transaction: the vendor and the
+// purchase order when they are new, then the purchase columns of the item,
+// which is pointed at the vendor and order when they are given.
+func (r *purchasingRepository) ImportPurchase(vendor *model.Vendor, order *model.PurchaseOrder, item *itemmodel.Item) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if vendor != nil {
+			if vendor.ID == 0 {
+				if err := tx.Create(vendor).Error; err != nil {
+					return err
+				}
+			}
+			item.VendorID = &vendor.ID
+		}
+		if order != nil {
+			if order.ID == 0 {
+				if vendor != nil {
+					order.VendorID = vendor.ID
+				}
+				if err := tx.Omit("Vendor").Create(order).Error; err != nil {
+					return err
+				}
+			}
+			item.PurchaseOrderID = &order.ID
+		}
+		return tx.Model(&itemmodel.Item{}).Where("id = ?", item.ID).Updates(map[string]interface{}{
+			"vendor_id":         item.VendorID,
+			"purchase_order_id": item.PurchaseOrderID,
+			"purchase_price":    item.PurchasePrice,
+			"currency":          item.Currency,
+			"invoice_no":        item.InvoiceNo,
+		}).Error
+	})
+}
+
+// GetSpend totals the purchase price of the items that have one, by the
+// given grouping and currency, largest first.
+func (r *purchasingRepository) GetSpend(grouping model.SpendGrouping) ([]model.SpendRow, error) {
+	group, ok := spendGroups[grouping]
+	if !ok {
+		return nil, fmt.Errorf("unknown spend grouping %q", grouping)
+	}
+	query := db.DB.Table("items").
+		Select(group.key + " AS key, " + group.label + " AS label, " +
+			"COALESCE(items.currency, purchase_orders.currency, '') AS currency, " +
+			"COUNT(*) AS item_count, SUM(items.purchase_price) AS total").
+		Joins("LEFT JOIN purchase_orders ON purchase_orders.id = items.purchase_order_id")
+	if group.joins != "" {
+		query = query.Joins(group.joins)
+	}
+	var rows []model.SpendRow
+	err := query.
+		Where("items.deleted_at IS NULL AND items.purchase_price IS NOT NULL").
+		Group("1, 2, 3").
+		Order("total DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"stockify_backend_golang/src/common/event"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/purchasing/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Accepted header names for each import column, compared after lower-casing
+// and turning spaces and dashes into underscores
+var importColumns = map[string][]string{
+	"asset_no":   {"asset_no", "asset", "asset_number"},
+	"vendor":     {"vendor", "vendor_name", "supplier"},
+	"po_number":  {"po_number", "po", "purchase_order"},
+	"order_date": {"order_date", "po_date", "purchase_date"},
+	"price":      {"price", "purchase_price", "cost"},
+	"currency":   {"currency"},
+	"invoice_no": {"invoice_no", "invoice", "invoice_number"},
+}
+
+// ImportPurchasesCSV records purchase data on existing items, matched by asset
+// number. Vendors and purchase orders named in the file are created when they
+// do not exist yet. Empty cells leave the item's current value alone. A bad
+// row is reported and skipped without stopping the import.
+func (s *purchasingService) ImportPurchasesCSV(r io.Reader) (model.PurchaseImportResult, error) {
+	result := model.PurchaseImportResult{Errors: []model.PurchaseImportError{}}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return result, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := mapImportColumns(header)
+	if _, ok := columns["asset_no"]; !ok {
+		return result, errors.New("CSV has no asset_no column")
+	}
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			result.Errors = append(result.Errors, model.PurchaseImportError{Line: line, Message: err.Error()})
+			continue
+		}
+		cell := func(name string) string {
+			if index, ok := columns[name]; ok && index < len(record) {
+				return strings.TrimSpace(record[index])
+			}
+			return ""
+		}
+		if err := s.importRow(cell, &result); err != nil {
+			result.Errors = append(result.Errors, model.PurchaseImportError{Line: line, Message: err.Error()})
+		}
+	}
+	return result, nil
+}
+
+// importRow checks a row against the item and the existing vendors and
+// orders first and then saves it in one transaction, so a row either lands
+// completely or not at all.
+func (s *purchasingService) importRow(cell func(string) string, result *model.PurchaseImportResult) error {
+	assetNo := cell("asset_no")
+	if assetNo == "" {
+		return errors.New("asset_no is empty")
+	}
+	itemId := s.repo.GetItemIdByAssetNo(assetNo)
+	if itemId == 0 {
+		return fmt.Errorf("no item with asset number %s", assetNo)
+	}
+	item := s.itemService.GetItemById(itemId)
+	previous := item
+
+	// Start from what the item has and overlay the cells that are filled in
+	var vendor *model.Vendor
+	if name := cell("vendor"); name != "" {
+		found := s.repo.GetVendorByName(name)
+		if found.ID == 0 {
+			found.Name = name
+			if err := s.validateVendor(&found); err != nil {
+				return err
+			}
+		}
+		vendor = &found
+	}
+	if value := cell("price"); value != "" {
+		price, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+		if err != nil {
+			return fmt.Errorf("invalid price %q", value)
+		}
+		if price < 0 {
+			return errors.New("purchase price cannot be negative")
+		}
+		item.PurchasePrice = &price
+	}
+	if value := cell("currency"); value != "" {
+		currency, err := normalizeCurrency(value)
+		if err != nil {
+			return err
+		}
+		item.Currency = &currency
+	}
+	if value := cell("invoice_no"); value != "" {
+		item.InvoiceNo = &value
+	}
+
+	var order *model.PurchaseOrder
+	if number := cell("po_number"); number != "" {
+		found := s.repo.GetPurchaseOrderByNumber(number)
+		switch {
+		case found.ID == 0:
+			found = model.PurchaseOrder{Number: number, Currency: cell("currency")}
+			switch {
+			case vendor != nil:
+				// The repository fills in the id of a vendor that is new as well
+				found.VendorID = vendor.ID
+			case item.VendorID != nil:
+				found.VendorID = *item.VendorID
+			default:
+				return fmt.Errorf("purchase order %s needs a vendor", number)
+			}
+			if value := cell("order_date"); value != "" {
+				date, err := time.Parse(time.DateOnly, value)
+				if err != nil {
+					return fmt.Errorf("invalid order_date %q, expected YYYY-MM-DD", value)
+				}
+				found.OrderDate = &date
+			}
+			if err := s.validateOrderDetails(&found); err != nil {
+				return err
+			}
+		case vendor == nil:
+			// The order decides the vendor when the row does not name one
+			item.VendorID = &found.VendorID
+		case vendor.ID != found.VendorID:
+			return fmt.Errorf("purchase order %s is from another vendor", found.Number)
+		}
+		order = &found
+	} else if vendor != nil && item.PurchaseOrderID != nil {
+		if current := s.repo.GetPurchaseOrderById(*item.PurchaseOrderID); current.ID != 0 && current.VendorID != vendor.ID {
+			return fmt.Errorf("purchase order %s is from another vendor", current.Number)
+		}
+	}
+	if order != nil && item.Currency == nil && order.Currency != "" {
+		item.Currency = &order.Currency
+	}
+
+	newVendor := vendor != nil && vendor.ID == 0
+	newOrder := order != nil && order.ID == 0
+	if err := s.repo.ImportPurchase(vendor, order, &item); err != nil {
+		return err
+	}
+	if newVendor {
+		result.VendorsCreated++
+	}
+	if newOrder {
+		result.OrdersCreated++
+	}
+	result.ItemsUpdated++
+	event.Publish(itemmodel.ITEM_UPDATED, itemmodel.ItemEvent{Item: s.itemService.GetItemById(item.ID), Previous: &previous})
+	return nil
+}
+
+func mapImportColumns(header []string) map[string]int {
+	columns := make(map[string]int)
+	for index, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+		for column, aliases := range importColumns {
+			for _, alias := range aliases {
+				if name == alias {
+					if _, seen := columns[column]; !seen {
+						columns[column] = index
+					}
+				}
+			}
+		}
+	}
+	return columns
+}
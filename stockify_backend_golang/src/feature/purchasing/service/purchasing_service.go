@@ -0,0 +1,24 @@
+package service
+
+import (
+	"io"
+	"stockify_backend_golang/src/feature/purchasing/model"
+)
+
+type PurchasingService interface {
+	GetAllVendors() []model.Vendor
+	GetVendorById(id uint64) model.Vendor
+	AddVendor(vendor model.Vendor) error
+	UpdateVendor(vendor model.Vendor) error
+	DeleteVendorById(id uint64) error
+
+	GetAllPurchaseOrders() []model.PurchaseOrder
+	GetPurchaseOrderById(id uint64) model.PurchaseOrder
+	AddPurchaseOrder(order model.PurchaseOrder) error
+	UpdatePurchaseOrder(order model.PurchaseOrder) error
+	DeletePurchaseOrderById(id uint64) error
+
+	SetItemPurchase(itemId uint64, purchase model.ItemPurchase) error
+	GetSpend(grouping model.SpendGrouping) ([]model.SpendRow, error)
+	ImportPurchasesCSV(r io.Reader) (model.PurchaseImportResult, error)
+}
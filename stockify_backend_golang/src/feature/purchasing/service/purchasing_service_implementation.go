@@ -0,0 +1,213 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/purchasing/model"
+	"stockify_backend_golang/src/feature/purchasing/repository"
+	"strings"
+)
+
+type purchasingService struct {
+	repo        repository.PurchasingRepository
+	itemService itemservice.ItemService
+}
+
+func PurchasingServiceImplementation(repo repository.PurchasingRepository, itemService itemservice.ItemService) PurchasingService {
+	return &purchasingService{repo: repo, itemService: itemService}
+}
+
+func (s *purchasingService) GetAllVendors() []model.Vendor {
+	return s.repo.GetAllVendors()
+}
+
+func (s *purchasingService) GetVendorById(id uint64) model.Vendor {
+	return s.repo.GetVendorById(id)
+}
+
+func (s *purchasingService) AddVendor(vendor model.Vendor) error {
+	return s.addVendor(&vendor)
+}
+
+// addVendor leaves the id of the new vendor in vendor
+func (s *purchasingService) addVendor(vendor *model.Vendor) error {
+	if err := s.validateVendor(vendor); err != nil {
+		return err
+	}
+	return s.repo.AddVendor(vendor)
+}
+
+func (s *purchasingService) UpdateVendor(vendor model.Vendor) error {
+	existing := s.repo.GetVendorById(vendor.ID)
+	if existing.ID == 0 {
+		return errors.New("vendor not found")
+	}
+	if err := s.validateVendor(&vendor); err != nil {
+		return err
+	}
+	vendor.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateVendor(vendor)
+}
+
+func (s *purchasingService) DeleteVendorById(id uint64) error {
+	if count := s.repo.CountVendorReferences(id); count > 0 {
+		return fmt.Errorf("vendor is used by %d purchase order(s) or item(s)", count)
+	}
+	s.repo.DeleteVendorById(id)
+	return nil
+}
+
+func (s *purchasingService) GetAllPurchaseOrders() []model.PurchaseOrder {
+	return s.repo.GetAllPurchaseOrders()
+}
+
+func (s *purchasingService) GetPurchaseOrderById(id uint64) model.PurchaseOrder {
+	return s.repo.GetPurchaseOrderById(id)
+}
+
+func (s *purchasingService) AddPurchaseOrder(order model.PurchaseOrder) error {
+	if err := s.validatePurchaseOrder(&order); err != nil {
+		return err
+	}
+	return s.repo.AddPurchaseOrder(&order)
+}
+
+func (s *purchasingService) UpdatePurchaseOrder(order model.PurchaseOrder) error {
+	existing := s.repo.GetPurchaseOrderById(order.ID)
+	if existing.ID == 0 {
+		return errors.New("purchase order not found")
+	}
+	if err := s.validatePurchaseOrder(&order); err != nil {
+		return err
+	}
+	if order.VendorID != existing.VendorID && s.repo.CountPurchaseOrderItems(order.ID) > 0 {
+		return errors.New("cannot change the vendor of a purchase order that has items")
+	}
+	order.CreatedAt = existing.CreatedAt
+	return s.repo.UpdatePurchaseOrder(order)
+}
+
+func (s *purchasingService) DeletePurchaseOrderById(id uint64) error {
+	if count := s.repo.CountPurchaseOrderItems(id); count > 0 {
+		return fmt.Errorf("purchase order has %d item(s)", count)
+	}
+	s.repo.DeletePurchaseOrderById(id)
+	return nil
+}
+
+// SetItemPurchase records where and for how much an item was bought. The
+// vendor defaults to the purchase order's, and so does the currency.
+func (s *purchasingService) SetItemPurchase(itemId uint64, purchase model.ItemPurchase) error {
+	item := s.itemService.GetItemById(itemId)
+	if item.ID == 0 {
+		return errors.New("item not found")
+	}
+	if err := s.applyPurchase(&item, purchase); err != nil {
+		return err
+	}
+	return s.itemService.UpdateItem(item)
+}
+
+func (s *purchasingService) GetSpend(grouping model.SpendGrouping) ([]model.SpendRow, error) {
+	return s.repo.GetSpend(grouping)
+}
+
+func (s *purchasingService) applyPurchase(item *itemmodel.Item, purchase model.ItemPurchase) error {
+	if purchase.PurchasePrice != nil && *purchase.PurchasePrice < 0 {
+		return errors.New("purchase price cannot be negative")
+	}
+	currency, err := normalizeCurrency(purchase.Currency)
+	if err != nil {
+		return err
+	}
+	if purchase.PurchaseOrderID != 0 {
+		order := s.repo.GetPurchaseOrderById(purchase.PurchaseOrderID)
+		if order.ID == 0 {
+			return errors.New("purchase order not found")
+		}
+		if purchase.VendorID == 0 {
+			purchase.VendorID = order.VendorID
+		} else if purchase.VendorID != order.VendorID {
+			return fmt.Errorf("purchase order %s is from another vendor", order.Number)
+		}
+		if currency == "" {
+			currency = order.Currency
+		}
+	}
+	if purchase.VendorID != 0 && s.repo.GetVendorById(purchase.VendorID).ID == 0 {
+		return errors.New("vendor not found")
+	}
+	item.VendorID = idOrNil(purchase.VendorID)
+	item.PurchaseOrderID = idOrNil(purchase.PurchaseOrderID)
+	item.PurchasePrice = purchase.PurchasePrice
+	item.Currency = stringOrNil(currency)
+	item.InvoiceNo = stringOrNil(strings.TrimSpace(purchase.InvoiceNo))
+	return nil
+}
+
+func (s *purchasingService) validateVendor(vendor *model.Vendor) error {
+	vendor.Name = strings.TrimSpace(vendor.Name)
+	if vendor.Name == "" {
+		return errors.New("vendor name is required")
+	}
+	if other := s.repo.GetVendorByName(vendor.Name); other.ID != 0 && other.ID != vendor.ID {
+		return fmt.Errorf("vendor %q already exists", vendor.Name)
+	}
+	return nil
+}
+
+func (s *purchasingService) validatePurchaseOrder(order *model.PurchaseOrder) error {
+	if err := s.validateOrderDetails(order); err != nil {
+		return err
+	}
+	if s.repo.GetVendorById(order.VendorID).ID == 0 {
+		return errors.New("vendor not found")
+	}
+	return nil
+}
+
+// validateOrderDetails checks everything of an order but its vendor, which
+// the CSV import may create in the same transaction.
+func (s *purchasingService) validateOrderDetails(order *model.PurchaseOrder) error {
+	order.Number = strings.TrimSpace(order.Number)
+	if order.Number == "" {
+		return errors.New("purchase order number is required")
+	}
+	if other := s.repo.GetPurchaseOrderByNumber(order.Number); other.ID != 0 && other.ID != order.ID {
+		return fmt.Errorf("purchase order %s already exists", order.Number)
+	}
+	currency, err := normalizeCurrency(order.Currency)
+	if err != nil {
+		return err
+	}
+	order.Currency = currency
+	return nil
+}
+
+// normalizeCurrency upper-cases an ISO 4217 code such as "eur"; empty stays empty
+func normalizeCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return "", nil
+	}
+	if len(currency) != 3 || strings.Trim(currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return "", fmt.Errorf("invalid currency code %q", currency)
+	}
+	return currency, nil
+}
+
+func idOrNil(id uint64) *uint64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,9 @@
+package model
+
+type ReportKind string
+
+const (
+	SUMMARY     ReportKind = "summary"
+	WARRANTY    ReportKind = "warranty"
+	ASSIGNMENTS ReportKind = "assignments"
+)
@@ -0,0 +1,36 @@
+package model
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+)
+
+// DefaultWarrantyWithinDays is the warranty report window when none is given
+const DefaultWarrantyWithinDays = 90
+
+// ReportOptions is decoded from the optionsJSON argument of GenerateReport.
+// The item filters narrow every report kind; the remaining fields only apply
+// to the kind named in their comment.
+type ReportOptions struct {
+	Title       string                 `json:"title"`
+	Search      string                 `json:"search"`
+	DeviceType  *itemmodel.DeviceType  `json:"deviceType,omitempty"`
+	AssetStatus *itemmodel.AssetStatus `json:"assetStatus,omitempty"`
+	// Only items matched by this saved search
+	SavedSearchID *uint64 `json:"savedSearchId,omitempty"`
+	// Warranty: include items expiring within this many days
+	WithinDays int `json:"withinDays"`
+	// Warranty: also include items whose warranty has already expired
+	IncludeExpired bool `json:"includeExpired"`
+	// Assignments: restrict the sheets to these users, all users when empty
+	UserIDs []uint64 `json:"userIds"`
+}
+
+func (o ReportOptions) ItemFilter() itemmodel.ItemFilterParams {
+	return itemmodel.ItemFilterParams{
+		Search:        o.Search,
+		DeviceType:    o.DeviceType,
+		AssetStatus:   o.AssetStatus,
+		SavedSearchID: o.SavedSearchID,
+		SortBy:        "asset_no",
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"fmt"
+	"stockify_backend_golang/src/common/pdf"
+)
+
+const (
+	pageMargin     = 40.0
+	headerHeight   = 36.0
+	footerHeight   = 24.0
+	bodyFontSize   = 9.0
+	headerFontSize = 9.5
+	rowHeight      = 15.0
+)
+
+type column struct {
+	title string
+	width float64
+	right bool
+}
+
+// reportWriter lays report content out top to bottom, starting a new page
+// whenever the next block does not fit.
+type reportWriter struct {
+	doc   *pdf.Document
+	page  *pdf.Page
+	title string
+	y     float64
+}
+
+func newReportWriter(title string) *reportWriter {
+	w := &reportWriter{doc: pdf.NewDocument(pdf.A4Width, pdf.A4Height), title: title}
+	w.newPage()
+	return w
+}
+
+func (w *reportWriter) contentWidth() float64 {
+	return w.doc.Width - 2*pageMargin
+}
+
+func (w *reportWriter) newPage() {
+	w.page = w.doc.AddPage()
+	w.page.SetFillColor(0.4, 0.4, 0.4)
+	w.page.Text(pageMargin, pageMargin, pdf.Regular, 8, "Stockify - "+w.title)
+	w.page.SetFillColor(0, 0, 0)
+	w.page.SetStrokeColor(0.8, 0.8, 0.8)
+	w.page.Line(pageMargin, pageMargin+6, w.doc.Width-pageMargin, pageMargin+6, 0.5)
+	w.y = pageMargin + headerHeight
+}
+
+// ensureSpace starts a new page unless height points are left on this one.
+func (w *reportWriter) ensureSpace(height float64) bool {
+	if w.y+height > w.doc.Height-pageMargin-footerHeight {
+		w.newPage()
+		return true
+	}
+	return false
+}
+
+func (w *reportWriter) heading(text string, size float64) {
+	w.ensureSpace(size*2 + rowHeight)
+	w.y += size
+	w.page.Text(pageMargin, w.y, pdf.Bold, size, text)
+	w.y += size * 0.8
+}
+
+func (w *reportWriter) paragraph(text string) {
+	w.ensureSpace(rowHeight)
+	w.y += rowHeight - 4
+	w.page.Text(pageMargin, w.y, pdf.Regular, bodyFontSize, text)
+	w.y += 4
+}
+
+func (w *reportWriter) space(height float64) {
+	w.y += height
+}
+
+// keyValues prints label/value pairs in two aligned columns.
+func (w *reportWriter) keyValues(pairs [][2]string) {
+	for _, pair := range pairs {
+		w.ensureSpace(rowHeight)
+		w.y += rowHeight - 4
+		w.page.Text(pageMargin, w.y, pdf.Bold, bodyFontSize, pair[0])
+		w.page.Text(pageMargin+140, w.y, pdf.Regular, bodyFontSize, pair[1])
+		w.y += 4
+	}
+}
+
+// table draws rows under a header row, repeating the header after a page
+// break. Column widths are fractions of the content width.
+func (w *reportWriter) table(columns []column, rows [][]string) {
+	w.ensureSpace(rowHeight * 2)
+	w.tableHeader(columns)
+	for i, row := range rows {
+		if w.ensureSpace(rowHeight) {
+			w.tableHeader(columns)
+		}
+		if i%2 == 1 {
+			w.page.SetFillColor(0.95, 0.95, 0.95)
+			w.page.Rect(pageMargin, w.y, w.contentWidth(), rowHeight)
+			w.page.SetFillColor(0, 0, 0)
+		}
+		w.tableRow(columns, row, pdf.Regular, bodyFontSize)
+	}
+	if len(rows) == 0 {
+		w.paragraph("No items.")
+	}
+	w.y += rowHeight / 2
+}
+
+func (w *reportWriter) tableHeader(columns []column) {
+	w.page.SetFillColor(0.85, 0.88, 0.93)
+	w.page.Rect(pageMargin, w.y, w.contentWidth(), rowHeight)
+	w.page.SetFillColor(0, 0, 0)
+	titles := make([]string, len(columns))
+	for i, c := range columns {
+		titles[i] = c.title
+	}
+	w.tableRow(columns, titles, pdf.Bold, headerFontSize)
+}
+
+func (w *reportWriter) tableRow(columns []column, cells []string, font pdf.Font, size float64) {
+	x := pageMargin
+	baseline := w.y + rowHeight - 4
+	for i, c := range columns {
+		width := c.width * w.contentWidth()
+		if i < len(cells) {
+			text := pdf.Truncate(cells[i], font, size, width-6)
+			if c.right {
+				w.page.TextRight(x+width-3, baseline, font, size, text)
+			} else {
+				w.page.Text(x+3, baseline, font, size, text)
+			}
+		}
+		x += width
+	}
+	w.y += rowHeight
+}
+
+// bars draws a horizontal bar per label scaled against the largest value.
+func (w *reportWriter) bars(labels []string, values []int) {
+	max := 0
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+	labelWidth := 110.0
+	barWidth := w.contentWidth() - labelWidth - 40
+	for i, label := range labels {
+		w.ensureSpace(rowHeight)
+		baseline := w.y + rowHeight - 4
+		w.page.Text(pageMargin, baseline, pdf.Regular, bodyFontSize, label)
+		if max > 0 && values[i] > 0 {
+			w.page.SetFillColor(0.26, 0.45, 0.77)
+			w.page.Rect(pageMargin+labelWidth, w.y+3, barWidth*float64(values[i])/float64(max), rowHeight-6)
+			w.page.SetFillColor(0, 0, 0)
+		}
+		w.page.TextRight(w.doc.Width-pageMargin, baseline, pdf.Regular, bodyFontSize, fmt.Sprint(values[i]))
+		w.y += rowHeight
+	}
+	w.y += rowHeight / 2
+}
+
+// finish adds "Page n of m" footers and writes the document.
+func (w *reportWriter) finish(outPath string) error {
+	total := w.doc.PageCount()
+	for i := 0; i < total; i++ {
+		page := w.doc.Page(i)
+		page.SetFillColor(0.4, 0.4, 0.4)
+		page.TextRight(w.doc.Width-pageMargin, w.doc.Height-pageMargin+10, pdf.Regular, 8,
+			fmt.Sprintf("Page %d of %d", i+1, total))
+	}
+	return w.doc.Save(outPath)
+}
@@ -0,0 +1,10 @@
+package service
+
+import (
+	"stockify_backend_golang/src/feature/report/model"
+	"time"
+)
+
+type ReportService interface {
+	GenerateReport(kind model.ReportKind, options model.ReportOptions, outPath string, now time.Time) error
+}
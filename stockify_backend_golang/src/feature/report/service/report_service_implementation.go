@@ -0,0 +1,361 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"stockify_backend_golang/src/common/pdf"
+	"stockify_backend_golang/src/common/timeutil"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemrepository "stockify_backend_golang/src/feature/item/repository"
+	noterepository "stockify_backend_golang/src/feature/note/repository"
+	"stockify_backend_golang/src/feature/report/model"
+	usermodel "stockify_backend_golang/src/feature/user/model"
+	userrepository "stockify_backend_golang/src/feature/user/repository"
+	"strings"
+	"time"
+)
+
+type reportService struct {
+	itemRepo itemrepository.ItemRepository
+	userRepo userrepository.UserRepository
+	noteRepo noterepository.NoteRepository
+}
+
+func ReportServiceImplementation(
+	itemRepo itemrepository.ItemRepository,
+	userRepo userrepository.UserRepository,
+	noteRepo noterepository.NoteRepository,
+) ReportService {
+	return &reportService{itemRepo: itemRepo, userRepo: userRepo, noteRepo: noteRepo}
+}
+
+func (s *reportService) GenerateReport(kind model.ReportKind, options model.ReportOptions, outPath string, now time.Time) error {
+	if outPath == "" {
+		return errors.New("output path is required")
+	}
+	items, err := s.itemRepo.GetFilteredItems(options.ItemFilter())
+	if err != nil {
+		return err
+	}
+
+	var w *reportWriter
+	switch kind {
+	case model.SUMMARY:
+		w = newReportWriter(titleOr(options.Title, "Inventory Summary"))
+		s.writeSummary(w, items, now)
+	case model.WARRANTY:
+		w = newReportWriter(titleOr(options.Title, "Warranty Expiry Report"))
+		s.writeWarranty(w, items, options, now)
+	case model.ASSIGNMENTS:
+		w = newReportWriter(titleOr(options.Title, "Assignment Sheets"))
+		s.writeAssignments(w, items, options, now)
+	default:
+		return fmt.Errorf("unknown report kind %q", kind)
+	}
+	return w.finish(outPath)
+}
+
+func (s *reportService) writeSummary(w *reportWriter, items []itemmodel.Item, now time.Time) {
+	w.heading(w.title, 18)
+	w.paragraph("Generated " + now.Format("2006-01-02 15:04"))
+	w.space(6)
+
+	byType := map[itemmodel.DeviceType]map[itemmodel.AssetStatus]int{}
+	byStatus := map[itemmodel.AssetStatus]int{}
+	assigned, expired, expiring, noWarranty := 0, 0, 0, 0
+	for _, item := range items {
+		if byType[item.DeviceType] == nil {
+			byType[item.DeviceType] = map[itemmodel.AssetStatus]int{}
+		}
+		byType[item.DeviceType][item.AssetStatus]++
+		byStatus[item.AssetStatus]++
+		if item.AssignedToID != nil {
+			assigned++
+		}
+		if !hasWarranty(item) {
+			noWarranty++
+			continue
+		}
+		days := timeutil.DaysBetween(now, item.WarrantyDate)
+		if days < 0 {
+			expired++
+		} else if days <= itemmodel.DefaultExpiringWithinDays {
+			expiring++
+		}
+	}
+
+	w.heading("Overview", 12)
+	w.keyValues([][2]string{
+		{"Total items", fmt.Sprint(len(items))},
+		{"Assigned", fmt.Sprint(assigned)},
+		{"Unassigned", fmt.Sprint(len(items) - assigned)},
+		{"Warranty expired", fmt.Sprint(expired)},
+		{fmt.Sprintf("Expiring in %d days", itemmodel.DefaultExpiringWithinDays), fmt.Sprint(expiring)},
+		{"No warranty", fmt.Sprint(noWarranty)},
+	})
+	w.space(8)
+
+	w.heading("By device type", 12)
+	// Only statuses in use get a column, so the table stays within the page
+	var statuses []itemmodel.AssetStatus
+	for _, status := range itemmodel.AssetStatuses {
+		if byStatus[status] > 0 {
+			statuses = append(statuses, status)
+		}
+	}
+	columnWidth := 0.68 / float64(len(statuses)+1)
+	columns := []column{{title: "Device type", width: 0.32}}
+	for _, status := range statuses {
+		columns = append(columns, column{title: string(status), width: columnWidth, right: true})
+	}
+	columns = append(columns, column{title: "Total", width: columnWidth, right: true})
+	var rows [][]string
+	var typeLabels []string
+	var typeTotals []int
+	for _, deviceType := range orderedDeviceTypes(byType) {
+		row := []string{string(deviceType)}
+		total := 0
+		for _, status := range statuses {
+			row = append(row, fmt.Sprint(byType[deviceType][status]))
+		}
+		for _, count := range byType[deviceType] {
+			total += count
+		}
+		rows = append(rows, append(row, fmt.Sprint(total)))
+		typeLabels = append(typeLabels, string(deviceType))
+		typeTotals = append(typeTotals, total)
+	}
+	w.table(columns, rows)
+	w.bars(typeLabels, typeTotals)
+
+	w.heading("By asset status", 12)
+	var statusLabels []string
+	var statusTotals []int
+	for _, status := range statuses {
+		statusLabels = append(statusLabels, string(status))
+		statusTotals = append(statusTotals, byStatus[status])
+	}
+	w.bars(statusLabels, statusTotals)
+}
+
+func (s *reportService) writeWarranty(w *reportWriter, items []itemmodel.Item, options model.ReportOptions, now time.Time) {
+	withinDays := options.WithinDays
+	if withinDays <= 0 {
+		withinDays = model.DefaultWarrantyWithinDays
+	}
+	w.heading(w.title, 18)
+	description := fmt.Sprintf("Items whose warranty expires within %d days", withinDays)
+	if options.IncludeExpired {
+		description += ", including expired warranties"
+	}
+	w.paragraph(description + ". Generated " + now.Format("2006-01-02 15:04"))
+	w.space(6)
+
+	var selected []itemmodel.Item
+	for _, item := range items {
+		// Without a warranty date there is nothing to expire
+		if !hasWarranty(item) {
+			continue
+		}
+		days := timeutil.DaysBetween(now, item.WarrantyDate)
+		if days <= withinDays && (days >= 0 || options.IncludeExpired) {
+			selected = append(selected, item)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].WarrantyDate.Before(selected[j].WarrantyDate)
+	})
+
+	columns := []column{
+		{title: "Asset No", width: 0.14},
+		{title: "Type", width: 0.11},
+		{title: "Model", width: 0.16},
+		{title: "Serial No", width: 0.15},
+		{title: "Assigned to", width: 0.17},
+		{title: "Warranty", width: 0.14},
+		{title: "Days left", width: 0.13, right: true},
+	}
+	rows := make([][]string, 0, len(selected))
+	for _, item := range selected {
+		days := timeutil.DaysBetween(now, item.WarrantyDate)
+		daysLeft := fmt.Sprint(days)
+		if days < 0 {
+			daysLeft = "Expired"
+		}
+		rows = append(rows, []string{
+			item.AssetNo,
+			string(item.DeviceType),
+			item.ModelNo,
+			item.SerialNo,
+			assigneeName(item),
+			item.WarrantyDate.Format(time.DateOnly),
+			daysLeft,
+		})
+	}
+	w.table(columns, rows)
+	w.paragraph(fmt.Sprintf("%d item(s) listed.", len(selected)))
+}
+
+func (s *reportService) writeAssignments(w *reportWriter, items []itemmodel.Item, options model.ReportOptions, now time.Time) {
+	itemsByUser := map[uint64][]itemmodel.Item{}
+	for _, item := range items {
+		if item.AssignedToID != nil {
+			itemsByUser[*item.AssignedToID] = append(itemsByUser[*item.AssignedToID], item)
+		}
+	}
+
+	var users []usermodel.User
+	if len(options.UserIDs) > 0 {
+		for _, id := range options.UserIDs {
+			if user := s.userRepo.GetUserById(id); user.ID != 0 {
+				users = append(users, user)
+			}
+		}
+	} else {
+		for _, user := range s.userRepo.GetAllUsers() {
+			if len(itemsByUser[user.ID]) > 0 {
+				users = append(users, user)
+			}
+		}
+		sort.SliceStable(users, func(i, j int) bool { return users[i].UserName < users[j].UserName })
+	}
+
+	if len(users) == 0 {
+		w.heading(w.title, 18)
+		w.paragraph("No users with assigned items.")
+		return
+	}
+
+	columns := []column{
+		{title: "Asset No", width: 0.17},
+		{title: "Type", width: 0.13},
+		{title: "Model", width: 0.2},
+		{title: "Serial No", width: 0.2},
+		{title: "Status", width: 0.13},
+		{title: "Warranty", width: 0.17},
+	}
+	for i, user := range users {
+		if i > 0 {
+			w.newPage()
+		}
+		w.heading("Assignment sheet: "+user.UserName, 16)
+		w.keyValues([][2]string{
+			{"Designation", valueOr(user.Designation)},
+			{"SAP ID", valueOr(user.SapId)},
+			{"IP phone", valueOr(user.IpPhone)},
+			{"Room / floor", valueOr(user.RoomNo) + " / " + valueOr(user.Floor)},
+			{"Date", now.Format(time.DateOnly)},
+		})
+		w.space(8)
+
+		var rows [][]string
+		assetNos := map[uint64]string{}
+		var itemIds []uint64
+		for _, item := range itemsByUser[user.ID] {
+			assetNos[item.ID] = item.AssetNo
+			itemIds = append(itemIds, item.ID)
+			rows = append(rows, []string{
+				item.AssetNo,
+				string(item.DeviceType),
+				item.ModelNo,
+				item.SerialNo,
+				string(item.AssetStatus),
+				warrantyText(item),
+			})
+		}
+		w.table(columns, rows)
+		s.writeNotes(w, user, itemIds, assetNos)
+
+		w.ensureSpace(70)
+		w.space(45)
+		half := w.contentWidth() / 2
+		w.page.Line(pageMargin, w.y, pageMargin+half-30, w.y, 0.6)
+		w.page.Line(pageMargin+half+30, w.y, pageMargin+2*half, w.y, 0.6)
+		w.space(12)
+		w.page.Text(pageMargin, w.y, pdf.Regular, bodyFontSize, "Received by (signature / date)")
+		w.page.Text(pageMargin+half+30, w.y, pdf.Regular, bodyFontSize, "Issued by (signature / date)")
+	}
+}
+
+// writeNotes lists the notes on a user and on their items, oldest first.
+// Nothing is written when there are none.
+func (s *reportService) writeNotes(w *reportWriter, user usermodel.User, itemIds []uint64, assetNos map[uint64]string) {
+	notes := append(s.noteRepo.GetNotesByUserId(user.ID), s.noteRepo.GetNotesByItemIds(itemIds)...)
+	if len(notes) == 0 {
+		return
+	}
+	sort.SliceStable(notes, func(i, j int) bool { return notes[i].CreatedAt.Before(notes[j].CreatedAt) })
+	columns := []column{
+		{title: "Date", width: 0.15},
+		{title: "About", width: 0.15},
+		{title: "By", width: 0.15},
+		{title: "Note", width: 0.55},
+	}
+	rows := make([][]string, 0, len(notes))
+	for _, note := range notes {
+		about := user.UserName
+		if note.ItemID != nil {
+			about = assetNos[*note.ItemID]
+		}
+		rows = append(rows, []string{note.CreatedAt.Format(time.DateOnly), about, valueOr(&note.Author),
+			strings.Join(strings.Fields(note.Body), " ")})
+	}
+	w.heading("Notes", 12)
+	w.table(columns, rows)
+}
+
+// orderedDeviceTypes returns the built-in device types first, in their
+// declared order, followed by any other types found sorted by name.
+func orderedDeviceTypes(present map[itemmodel.DeviceType]map[itemmodel.AssetStatus]int) []itemmodel.DeviceType {
+	var ordered []itemmodel.DeviceType
+	known := map[itemmodel.DeviceType]bool{}
+	for _, deviceType := range itemmodel.DeviceTypes {
+		known[deviceType] = true
+		if present[deviceType] != nil {
+			ordered = append(ordered, deviceType)
+		}
+	}
+	var others []itemmodel.DeviceType
+	for deviceType := range present {
+		if !known[deviceType] {
+			others = append(others, deviceType)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	return append(ordered, others...)
+}
+
+func assigneeName(item itemmodel.Item) string {
+	if item.AssignedTo != nil {
+		return item.AssignedTo.UserName
+	}
+	return "-"
+}
+
+// hasWarranty is false for the zero time and for dates up to the epoch,
+// which the client sends when no warranty date is known
+func hasWarranty(item itemmodel.Item) bool {
+	return item.WarrantyDate.Unix() > 0
+}
+
+func warrantyText(item itemmodel.Item) string {
+	if !hasWarranty(item) {
+		return "No warranty"
+	}
+	return item.WarrantyDate.Format(time.DateOnly)
+}
+
+func valueOr(s *string) string {
+	if s == nil || *s == "" {
+		return "-"
+	}
+	return *s
+}
+
+func titleOr(title, fallback string) string {
+	if title == "" {
+		return fallback
+	}
+	return title
+}
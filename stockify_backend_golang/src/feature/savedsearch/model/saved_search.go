@@ -0,0 +1,34 @@
+package model
+
+import (
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+
+	"gorm.io/gorm"
+)
+
+// SavedSearch is a named item filter that can be run again by ID, e.g.
+// "Active monitors expiring this quarter on floor 4". Relative filters such
+// as IsExpiring are evaluated when the search runs. A shared search is
+// listed for every colleague, an unshared one only for its Owner.
+type SavedSearch struct {
+	gorm.Model
+	ID          uint64                     `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name        string                     `json:"Name"`
+	Description string                     `json:"Description,omitempty"`
+	Owner       string                     `gorm:"index" json:"Owner"`
+	Shared      bool                       `json:"Shared"`
+	Filter      itemmodel.ItemFilterParams `gorm:"serializer:json" json:"Filter"`
+}
+
+func (s *SavedSearch) String() string {
+	return fmt.Sprintf("SavedSearch{ID: %d, Name: %s, Owner: %s, Shared: %t}", s.ID, s.Name, s.Owner, s.Shared)
+}
+
+// SharedSearch is the portable form of a saved search, handed to colleagues
+// working on another database.
+type SharedSearch struct {
+	Name        string                     `json:"Name"`
+	Description string                     `json:"Description,omitempty"`
+	Filter      itemmodel.ItemFilterParams `json:"Filter"`
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/savedsearch/model"
+)
+
+type SavedSearchRepository interface {
+	GetSavedSearches(owner string) []model.SavedSearch
+	GetSavedSearchById(id uint64) model.SavedSearch
+	GetSavedSearchByName(owner, name string) model.SavedSearch
+	AddSavedSearch(search *model.SavedSearch) error
+	UpdateSavedSearch(search model.SavedSearch) error
+	DeleteSavedSearchById(id uint64) error
+	CountNotificationRulesBySavedSearchId(id uint64) int64
+}
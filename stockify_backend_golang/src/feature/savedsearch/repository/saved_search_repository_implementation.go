@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/savedsearch/model"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.SavedSearch{})
+	if err != nil {
+		log.Fatal("Failed to migrate SavedSearch table: " + err.Error())
+	}
+}
+
+// SavedFilter returns the filter of a saved search for the item repository,
+// which applies it for ItemFilterParams.SavedSearchID.
+func SavedFilter(id uint64) (itemmodel.ItemFilterParams, bool) {
+	var search model.SavedSearch
+	if err := db.DB.First(&search, id).Error; err != nil {
+		return itemmodel.ItemFilterParams{}, false
+	}
+	return search.Filter, true
+}
+
+type savedSearchRepository struct{}
+
+func SavedSearchRepositoryImplementation() SavedSearchRepository {
+	return &savedSearchRepository{}
+}
+
+// GetSavedSearches returns the searches of the owner and the shared ones of
+// everybody else, by name. An empty owner gets every search.
+func (r *savedSearchRepository) GetSavedSearches(owner string) []model.SavedSearch {
+	var searches []model.SavedSearch
+	query := db.DB.Order("name COLLATE NOCASE, id")
+	if owner != "" {
+		query = query.Where("owner = ? OR shared", owner)
+	}
+	query.Find(&searches)
+	return searches
+}
+
+func (r *savedSearchRepository) GetSavedSearchById(id uint64) model.SavedSearch {
+	var search model.SavedSearch
+	db.DB.First(&search, id)
+	return search
+}
+
+func (r *savedSearchRepository) GetSavedSearchByName(owner, name string) model.SavedSearch {
+	var search model.SavedSearch
+	db.DB.Where("owner = ? AND name = ? COLLATE NOCASE", owner, name).First(&search)
+	return search
+}
+
+func (r *savedSearchRepository) AddSavedSearch(search *model.SavedSearch) error {
+	return db.DB.Create(search).Error
+}
+
+func (r *savedSearchRepository) UpdateSavedSearch(search model.SavedSearch) error {
+	return db.DB.Save(&search).Error
+}
+
+func (r *savedSearchRepository) DeleteSavedSearchById(id uint64) error {
+	return db.DB.Delete(&model.SavedSearch{}, id).Error
+}
+
+func (r *savedSearchRepository) CountNotificationRulesBySavedSearchId(id uint64) int64 {
+	var count int64
+	db.DB.Table("notification_rules").Where("saved_search_id = ? AND deleted_at IS NULL", id).Count(&count)
+	return count
+}
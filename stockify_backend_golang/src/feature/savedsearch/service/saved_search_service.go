@@ -0,0 +1,17 @@
+package service
+
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	"stockify_backend_golang/src/feature/savedsearch/model"
+)
+
+type SavedSearchService interface {
+	GetSavedSearches(owner string) []model.SavedSearch
+	GetSavedSearchById(id uint64) model.SavedSearch
+	AddSavedSearch(search model.SavedSearch) (model.SavedSearch, error)
+	UpdateSavedSearch(search model.SavedSearch) error
+	DeleteSavedSearchById(id uint64) error
+	RunSavedSearch(id uint64) ([]itemmodel.Item, error)
+	ShareSavedSearch(id uint64) (model.SharedSearch, error)
+	ImportSharedSearch(shared model.SharedSearch, owner string) (model.SavedSearch, error)
+}
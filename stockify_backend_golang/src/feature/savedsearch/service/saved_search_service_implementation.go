@@ -0,0 +1,136 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/savedsearch/model"
+	"stockify_backend_golang/src/feature/savedsearch/repository"
+	"strings"
+)
+
+type savedSearchService struct {
+	repo        repository.SavedSearchRepository
+	itemService itemservice.ItemService
+}
+
+func SavedSearchServiceImplementation(
+	repo repository.SavedSearchRepository,
+	itemService itemservice.ItemService,
+) SavedSearchService {
+	return &savedSearchService{repo: repo, itemService: itemService}
+}
+
+func (s *savedSearchService) GetSavedSearches(owner string) []model.SavedSearch {
+	return s.repo.GetSavedSearches(strings.TrimSpace(owner))
+}
+
+func (s *savedSearchService) GetSavedSearchById(id uint64) model.SavedSearch {
+	return s.repo.GetSavedSearchById(id)
+}
+
+func (s *savedSearchService) AddSavedSearch(search model.SavedSearch) (model.SavedSearch, error) {
+	if err := normalize(&search); err != nil {
+		return search, err
+	}
+	if s.repo.GetSavedSearchByName(search.Owner, search.Name).ID != 0 {
+		return search, fmt.Errorf("saved search %q already exists", search.Name)
+	}
+	search.ID = 0
+	err := s.repo.AddSavedSearch(&search)
+	return search, err
+}
+
+// UpdateSavedSearch only lets the owner edit a search; shared searches are
+// read-only for everybody else.
+func (s *savedSearchService) UpdateSavedSearch(search model.SavedSearch) error {
+	if err := normalize(&search); err != nil {
+		return err
+	}
+	existing := s.repo.GetSavedSearchById(search.ID)
+	if existing.ID == 0 {
+		return errors.New("saved search not found")
+	}
+	if search.Owner != existing.Owner {
+		return errors.New("only the owner can edit a saved search")
+	}
+	if other := s.repo.GetSavedSearchByName(search.Owner, search.Name); other.ID != 0 && other.ID != search.ID {
+		return fmt.Errorf("saved search %q already exists", search.Name)
+	}
+	search.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateSavedSearch(search)
+}
+
+// DeleteSavedSearchById refuses to delete a saved search that notification
+// rules still apply to.
+func (s *savedSearchService) DeleteSavedSearchById(id uint64) error {
+	if s.repo.GetSavedSearchById(id).ID == 0 {
+		return errors.New("saved search not found")
+	}
+	if count := s.repo.CountNotificationRulesBySavedSearchId(id); count > 0 {
+		return fmt.Errorf("saved search is used by %d notification rule(s)", count)
+	}
+	return s.repo.DeleteSavedSearchById(id)
+}
+
+// RunSavedSearch returns the items the saved search matches now
+func (s *savedSearchService) RunSavedSearch(id uint64) ([]itemmodel.Item, error) {
+	if s.repo.GetSavedSearchById(id).ID == 0 {
+		return nil, errors.New("saved search not found")
+	}
+	return s.itemService.GetFilteredItems(itemmodel.ItemFilterParams{SavedSearchID: &id})
+}
+
+// ShareSavedSearch returns the search in a form a colleague can import into
+// another database.
+func (s *savedSearchService) ShareSavedSearch(id uint64) (model.SharedSearch, error) {
+	search := s.repo.GetSavedSearchById(id)
+	if search.ID == 0 {
+		return model.SharedSearch{}, errors.New("saved search not found")
+	}
+	return model.SharedSearch{Name: search.Name, Description: search.Description, Filter: search.Filter}, nil
+}
+
+// ImportSharedSearch saves a search shared by a colleague for owner. When the
+// owner already has a search of that name, a number is added to the name.
+func (s *savedSearchService) ImportSharedSearch(shared model.SharedSearch, owner string) (model.SavedSearch, error) {
+	search := model.SavedSearch{
+		Name:        strings.TrimSpace(shared.Name),
+		Description: shared.Description,
+		Owner:       owner,
+		Filter:      shared.Filter,
+	}
+	if search.Name == "" {
+		return search, errors.New("saved search name is required")
+	}
+	base := search.Name
+	for n := 2; s.repo.GetSavedSearchByName(strings.TrimSpace(owner), search.Name).ID != 0; n++ {
+		search.Name = fmt.Sprintf("%s (%d)", base, n)
+	}
+	return s.AddSavedSearch(search)
+}
+
+// normalize tidies the search and checks it, including the sort, which
+// ends up in the ORDER BY of the item query
+func normalize(search *model.SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	search.Description = strings.TrimSpace(search.Description)
+	search.Owner = strings.TrimSpace(search.Owner)
+	// Saved searches do not chain; a filter naming another search would
+	// silently change whenever that one is edited
+	search.Filter.SavedSearchID = nil
+	if search.Name == "" {
+		return errors.New("saved search name is required")
+	}
+	filter := &search.Filter
+	filter.SortBy = strings.TrimSpace(filter.SortBy)
+	if filter.SortBy != "" && !itemmodel.SortColumns[filter.SortBy] {
+		return fmt.Errorf("cannot sort by %q", filter.SortBy)
+	}
+	filter.SortOrder = strings.ToUpper(strings.TrimSpace(filter.SortOrder))
+	if filter.SortOrder != "" && filter.SortOrder != "ASC" && filter.SortOrder != "DESC" {
+		return fmt.Errorf("sort order must be ASC or DESC, not %q", filter.SortOrder)
+	}
+	return nil
+}
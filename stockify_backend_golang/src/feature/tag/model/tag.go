@@ -0,0 +1,56 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Tag groups items freely, e.g. "project-x", "loaner" or "needs-reimage",
+// independent of their status. Names are unique and kept normalized, see
+// NormalizeTagName.
+type Tag struct {
+	gorm.Model
+	ID          uint64 `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name        string `gorm:"uniqueIndex" json:"Name"`
+	Color       string `json:"Color,omitempty"`
+	Description string `json:"Description,omitempty"`
+}
+
+func (t *Tag) String() string {
+	return fmt.Sprintf("Tag{ID: %d, Name: %s}", t.ID, t.Name)
+}
+
+// ItemTag links an item to a tag. It is the join table of Item.Tags.
+type ItemTag struct {
+	ItemID uint64 `gorm:"primaryKey" json:"ItemID"`
+	TagID  uint64 `gorm:"primaryKey;index" json:"TagID"`
+}
+
+// TagCount is the number of items carrying a tag
+type TagCount struct {
+	TagID uint64 `json:"TagID"`
+	Name  string `json:"Name"`
+	Count int64  `json:"Count"`
+}
+
+// NormalizeTagName lowercases a tag name and joins its words with dashes,
+// so "Project X" and "project-x" name the same tag.
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), "-"))
+}
+
+// NormalizeTagNames normalizes the names and drops empty and repeated ones
+func NormalizeTagNames(names []string) []string {
+	var normalized []string
+	seen := map[string]bool{}
+	for _, name := range names {
+		name = NormalizeTagName(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			normalized = append(normalized, name)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/tag/model"
+)
+
+type TagRepository interface {
+	GetAllTags() []model.Tag
+	GetTagById(id uint64) model.Tag
+	GetTagByName(name string) model.Tag
+	GetTagsByNames(names []string) []model.Tag
+	AddTag(tag *model.Tag) error
+	UpdateTag(tag model.Tag) error
+	DeleteTagById(id uint64) error
+
+	GetItemTags(itemId uint64) []model.Tag
+	AddItemTags(itemIds, tagIds []uint64) (int64, error)
+	RemoveItemTags(itemIds, tagIds []uint64) (int64, error)
+	ReplaceItemTags(itemId uint64, tagIds []uint64) error
+	DeleteItemTags(itemId uint64) error
+	GetTagCounts() []model.TagCount
+}
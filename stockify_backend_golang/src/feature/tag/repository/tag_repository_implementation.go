@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/tag/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Tag{}, &model.ItemTag{})
+	if err != nil {
+		log.Fatal("Failed to migrate Tag table: " + err.Error())
+	}
+}
+
+// TaggedAnyQuery selects the ids of items carrying at least one of the
+// tags; the single argument is the list of tag names.
+const TaggedAnyQuery = `SELECT item_tags.item_id FROM item_tags
+	JOIN tags ON tags.id = item_tags.tag_id
+	WHERE tags.name IN ? AND tags.deleted_at IS NULL`
+
+// TaggedAllQuery selects the ids of items carrying every one of the tags;
+// the arguments are the list of distinct tag names and its length.
+const TaggedAllQuery = TaggedAnyQuery + `
+	GROUP BY item_tags.item_id HAVING COUNT(DISTINCT tags.id) = ?`
+
+// TagCountsQuery selects (tag_id, name, count) for every tag, most used first
+const TagCountsQuery = `SELECT tags.id AS tag_id, tags.name AS name, COUNT(items.id) AS count
+	FROM tags
+	LEFT JOIN item_tags ON item_tags.tag_id = tags.id
+	LEFT JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL
+	WHERE tags.deleted_at IS NULL
+	GROUP BY tags.id, tags.name
+	ORDER BY COUNT(items.id) DESC, tags.name`
+
+type tagRepository struct{}
+
+func TagRepositoryImplementation() TagRepository {
+	return &tagRepository{}
+}
+
+func (r *tagRepository) GetAllTags() []model.Tag {
+	var tags []model.Tag
+	db.DB.Order("name").Find(&tags)
+	return tags
+}
+
+func (r *tagRepository) GetTagById(id uint64) model.Tag {
+	var tag model.Tag
+	db.DB.First(&tag, id)
+	return tag
+}
+
+func (r *tagRepository) GetTagByName(name string) model.Tag {
+	var tag model.Tag
+	db.DB.Where("name = ?", name).First(&tag)
+	return tag
+}
+
+func (r *tagRepository) GetTagsByNames(names []string) []model.Tag {
+	var tags []model.Tag
+	if len(names) == 0 {
+		return tags
+	}
+	db.DB.Where("name IN ?", names).Order("name").Find(&tags)
+	return tags
+}
+
+func (r *tagRepository) AddTag(tag *model.Tag) error {
+	return db.DB.Create(tag).Error
+}
+
+func (r *tagRepository) UpdateTag(tag model.Tag) error {
+	return db.DB.Save(&tag).Error
+}
+
+// DeleteTagById removes the tag for good, together with its links to items,
+// so the name can be used again.
+func (r *tagRepository) DeleteTagById(id uint64) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&model.ItemTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&model.Tag{}, id).Error
+	})
+}
+
+func (r *tagRepository) GetItemTags(itemId uint64) []model.Tag {
+	var tags []model.Tag
+	db.DB.Where("id IN (SELECT tag_id FROM item_tags WHERE item_id = ?)", itemId).Order("name").Find(&tags)
+	return tags
+}
+
+// AddItemTags puts every tag on every item and returns the number of links
+// added; links that already exist are left alone.
+func (r *tagRepository) AddItemTags(itemIds, tagIds []uint64) (int64, error) {
+	links := make([]model.ItemTag, 0, len(itemIds)*len(tagIds))
+	for _, itemId := range itemIds {
+		for _, tagId := range tagIds {
+			links = append(links, model.ItemTag{ItemID: itemId, TagID: tagId})
+		}
+	}
+	if len(links) == 0 {
+		return 0, nil
+	}
+	result := db.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&links)
+	return result.RowsAffected, result.Error
+}
+
+// RemoveItemTags takes every tag off every item and returns the number of
+// links removed.
+func (r *tagRepository) RemoveItemTags(itemIds, tagIds []uint64) (int64, error) {
+	if len(itemIds) == 0 || len(tagIds) == 0 {
+		return 0, nil
+	}
+	result := db.DB.Where("item_id IN ? AND tag_id IN ?", itemIds, tagIds).Delete(&model.ItemTag{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *tagRepository) ReplaceItemTags(itemId uint64, tagIds []uint64) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("item_id = ?", itemId).Delete(&model.ItemTag{}).Error; err != nil {
+			return err
+		}
+		if len(tagIds) == 0 {
+			return nil
+		}
+		links := make([]model.ItemTag, 0, len(tagIds))
+		for _, tagId := range tagIds {
+			links = append(links, model.ItemTag{ItemID: itemId, TagID: tagId})
+		}
+		return tx.Create(&links).Error
+	})
+}
+
+func (r *tagRepository) DeleteItemTags(itemId uint64) error {
+	return db.DB.Where("item_id = ?", itemId).Delete(&model.ItemTag{}).Error
+}
+
+// GetTagCounts counts the items, not deleted, carrying each tag. Unused
+// tags are listed with a count of zero.
+func (r *tagRepository) GetTagCounts() []model.TagCount {
+	counts := []model.TagCount{}
+	db.DB.Raw(TagCountsQuery).Scan(&counts)
+	return counts
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/tag/model"
+)
+
+type TagService interface {
+	GetAllTags() []model.Tag
+	GetTagById(id uint64) model.Tag
+	AddTag(tag model.Tag) (model.Tag, error)
+	UpdateTag(tag model.Tag) error
+	DeleteTagById(id uint64) error
+
+	GetItemTags(itemId uint64) []model.Tag
+	SetItemTags(itemId uint64, names []string) ([]model.Tag, error)
+	TagItems(itemIds []uint64, names []string) (int64, error)
+	UntagItems(itemIds []uint64, names []string) (int64, error)
+	GetTagCounts() []model.TagCount
+
+	HandleEvent(e event.Event)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"stockify_backend_golang/src/common/event"
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	itemservice "stockify_backend_golang/src/feature/item/service"
+	"stockify_backend_golang/src/feature/tag/model"
+	"stockify_backend_golang/src/feature/tag/repository"
+	"strings"
+)
+
+type tagService struct {
+	repo        repository.TagRepository
+	itemService itemservice.ItemService
+}
+
+func TagServiceImplementation(repo repository.TagRepository, itemService itemservice.ItemService) TagService {
+	return &tagService{repo: repo, itemService: itemService}
+}
+
+func (s *tagService) GetAllTags() []model.Tag {
+	return s.repo.GetAllTags()
+}
+
+func (s *tagService) GetTagById(id uint64) model.Tag {
+	return s.repo.GetTagById(id)
+}
+
+func (s *tagService) AddTag(tag model.Tag) (model.Tag, error) {
+	normalize(&tag)
+	if tag.Name == "" {
+		return tag, errors.New("tag name is required")
+	}
+	if s.repo.GetTagByName(tag.Name).ID != 0 {
+		return tag, fmt.Errorf("tag %q already exists", tag.Name)
+	}
+	tag.ID = 0
+	err := s.repo.AddTag(&tag)
+	return tag, err
+}
+
+// UpdateTag renames or recolors a tag; the items carrying it keep it
+func (s *tagService) UpdateTag(tag model.Tag) error {
+	normalize(&tag)
+	if tag.Name == "" {
+		return errors.New("tag name is required")
+	}
+	existing := s.repo.GetTagById(tag.ID)
+	if existing.ID == 0 {
+		return errors.New("tag not found")
+	}
+	if other := s.repo.GetTagByName(tag.Name); other.ID != 0 && other.ID != tag.ID {
+		return fmt.Errorf("tag %q already exists", tag.Name)
+	}
+	tag.CreatedAt = existing.CreatedAt
+	return s.repo.UpdateTag(tag)
+}
+
+// DeleteTagById deletes the tag and takes it off every item
+func (s *tagService) DeleteTagById(id uint64) error {
+	if s.repo.GetTagById(id).ID == 0 {
+		return errors.New("tag not found")
+	}
+	return s.repo.DeleteTagById(id)
+}
+
+func (s *tagService) GetItemTags(itemId uint64) []model.Tag {
+	return s.repo.GetItemTags(itemId)
+}
+
+// SetItemTags replaces the tags of an item with the named ones, creating
+// tags that do not exist yet, and returns the item's tags.
+func (s *tagService) SetItemTags(itemId uint64, names []string) ([]model.Tag, error) {
+	if err := s.checkItems([]uint64{itemId}); err != nil {
+		return nil, err
+	}
+	tagIds, err := s.tagIds(names)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceItemTags(itemId, tagIds); err != nil {
+		return nil, err
+	}
+	return s.repo.GetItemTags(itemId), nil
+}
+
+// TagItems puts the named tags on every item, creating tags that do not
+// exist yet. Returns the number of tags newly put on items.
+func (s *tagService) TagItems(itemIds []uint64, names []string) (int64, error) {
+	if err := s.checkItems(itemIds); err != nil {
+		return 0, err
+	}
+	if len(model.NormalizeTagNames(names)) == 0 {
+		return 0, errors.New("no tag given")
+	}
+	tagIds, err := s.tagIds(names)
+	if err != nil {
+		return 0, err
+	}
+	return s.repo.AddItemTags(itemIds, tagIds)
+}
+
+// UntagItems takes the named tags off every item. Names of tags that do not
+// exist are ignored. Returns the number of tags taken off items.
+func (s *tagService) UntagItems(itemIds []uint64, names []string) (int64, error) {
+	var tagIds []uint64
+	for _, tag := range s.repo.GetTagsByNames(model.NormalizeTagNames(names)) {
+		tagIds = append(tagIds, tag.ID)
+	}
+	return s.repo.RemoveItemTags(itemIds, tagIds)
+}
+
+func (s *tagService) GetTagCounts() []model.TagCount {
+	return s.repo.GetTagCounts()
+}
+
+func (s *tagService) checkItems(itemIds []uint64) error {
+	if len(itemIds) == 0 {
+		return errors.New("no item given")
+	}
+	for _, id := range itemIds {
+		if s.itemService.GetItemById(id).ID == 0 {
+			return fmt.Errorf("item %d not found", id)
+		}
+	}
+	return nil
+}
+
+// HandleEvent takes the tags off a deleted item
+func (s *tagService) HandleEvent(e event.Event) {
+	payload, ok := e.Data.(itemmodel.ItemEvent)
+	if !ok || e.Type != itemmodel.ITEM_DELETED {
+		return
+	}
+	if err := s.repo.DeleteItemTags(payload.Item.ID); err != nil {
+		log.Println("Failed to delete tags of item", payload.Item.ID, ":", err)
+	}
+}
+
+// tagIds returns the ids of the named tags, creating the missing ones
+func (s *tagService) tagIds(names []string) ([]uint64, error) {
+	names = model.NormalizeTagNames(names)
+	existing := map[string]uint64{}
+	for _, tag := range s.repo.GetTagsByNames(names) {
+		existing[tag.Name] = tag.ID
+	}
+	ids := make([]uint64, 0, len(names))
+	for _, name := range names {
+		if id, ok := existing[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		tag := model.Tag{Name: name}
+		if err := s.repo.AddTag(&tag); err != nil {
+			return nil, err
+		}
+		ids = append(ids, tag.ID)
+	}
+	return ids, nil
+}
+
+func normalize(tag *model.Tag) {
+	tag.Name = model.NormalizeTagName(tag.Name)
+	tag.Color = strings.TrimSpace(tag.Color)
+	tag.Description = strings.TrimSpace(tag.Description)
+}
@@ -1,7 +1,9 @@
 package model
 
 type UserQueryParams struct {
-	Search    string
-	SortBy    string
-	SortOrder string
+	Search     string
+	LocationID *uint64 // the location or anywhere below it
+	OrgUnitID  *uint64 // the org unit or any unit below it
+	SortBy     string
+	SortOrder  string
 }
@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 	"gorm.io/gorm"
+	notemodel "stockify_backend_golang/src/feature/note/model"
 	"strings"
 )
 
@@ -15,6 +16,12 @@ type User struct {
 	IpPhone     *string `json:"ipPhone,omitempty"`
 	RoomNo      *string `json:"roomNo,omitempty"`
 	Floor       *string `json:"floor,omitempty"`
+	LocationID  *uint64 `gorm:"index" json:"locationId,omitempty"`
+	OrgUnitID   *uint64 `gorm:"index" json:"orgUnitId,omitempty"`
+
+	// Notes on the user, oldest first, filled in when users are listed and
+	// managed through the note feature
+	Notes []notemodel.Note `gorm:"foreignKey:UserID" json:"notes,omitempty"`
 }
 
 func (u *User) String() string {
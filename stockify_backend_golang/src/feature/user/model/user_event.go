@@ -0,0 +1,14 @@
+package model
+
+import "stockify_backend_golang/src/common/event"
+
+const (
+	USER_UPDATED event.Type = "user.updated"
+	USER_DELETED event.Type = "user.deleted"
+)
+
+// UserEvent is the data published with user events.
+type UserEvent struct {
+	User     User  `json:"user"`
+	Previous *User `json:"previous,omitempty"`
+}
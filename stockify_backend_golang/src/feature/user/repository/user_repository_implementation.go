@@ -3,6 +3,9 @@ package repository
 import (
 	"log"
 	"stockify_backend_golang/src/common/db"
+	locationrepository "stockify_backend_golang/src/feature/location/repository"
+	noterepository "stockify_backend_golang/src/feature/note/repository"
+	orgunitrepository "stockify_backend_golang/src/feature/orgunit/repository"
 	"stockify_backend_golang/src/feature/user/model"
 )
 
@@ -20,12 +23,13 @@ func UserRepositoryImplementation() UserRepository {
 }
 
 func (r *userRepository) AddUser(user model.User) {
-	db.DB.Create(&user)
+	// Notes are added through the note feature
+	db.DB.Omit("Notes").Create(&user)
 }
 
 func (r *userRepository) GetAllUsers() []model.User {
 	var users []model.User
-	db.DB.Find(&users)
+	db.DB.Preload("Notes", noterepository.OldestFirst).Find(&users)
 	return users
 }
 
@@ -36,7 +40,7 @@ func (r *userRepository) GetUserById(id uint64) model.User {
 }
 
 func (r *userRepository) UpdateUser(user model.User) {
-	db.DB.Save(&user)
+	db.DB.Omit("Notes").Save(&user)
 }
 
 func (r *userRepository) DeleteUserById(id uint64) {
@@ -53,7 +57,16 @@ func (r *userRepository) GetFilteredUsers(params model.UserQueryParams) ([]model
 	// Searching
 	if params.Search != "" {
 		searchTerm := "%" + params.Search + "%"
-		database = database.Where("user_name LIKE ? OR sap_id LIKE ?", searchTerm, searchTerm)
+		database = database.Where("user_name LIKE ? OR sap_id LIKE ? OR id IN ("+noterepository.UserSearchQuery+")",
+			searchTerm, searchTerm, searchTerm)
+	}
+	// Location, including everything below it
+	if params.LocationID != nil && *params.LocationID != 0 {
+		database = database.Where("location_id IN ("+locationrepository.SubtreeQuery+")", *params.LocationID)
+	}
+	// Org unit, including every unit below it
+	if params.OrgUnitID != nil && *params.OrgUnitID != 0 {
+		database = database.Where("org_unit_id IN ("+orgunitrepository.SubtreeQuery+")", *params.OrgUnitID)
 	}
 	// Sorting
 	sortColumn := map[string]string{
@@ -68,7 +81,7 @@ func (r *userRepository) GetFilteredUsers(params model.UserQueryParams) ([]model
 		database = database.Order(sortColumn + " " + order)
 	}
 	var users []model.User
-	err := database.Find(&users).Error
+	err := database.Preload("Notes", noterepository.OldestFirst).Find(&users).Error
 	if err != nil {
 		return nil, err
 	}
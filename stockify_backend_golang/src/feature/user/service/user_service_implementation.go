@@ -1,6 +1,7 @@
 package service
 
 import (
+	"stockify_backend_golang/src/common/event"
 	"stockify_backend_golang/src/feature/user/model"
 	"stockify_backend_golang/src/feature/user/repository"
 )
@@ -26,11 +27,15 @@ func (s *userService) GetUserById(id uint64) model.User {
 }
 
 func (s *userService) UpdateUser(user model.User) {
+	previous := s.repo.GetUserById(user.ID)
 	s.repo.UpdateUser(user)
+	event.Publish(model.USER_UPDATED, model.UserEvent{User: s.repo.GetUserById(user.ID), Previous: &previous})
 }
 
 func (s *userService) DeleteUserById(id uint64) {
+	user := s.repo.GetUserById(id)
 	s.repo.DeleteUserById(id)
+	event.Publish(model.USER_DELETED, model.UserEvent{User: user})
 }
 
 func (s *userService) GetFilteredUsers(params model.UserQueryParams) ([]model.User, error) {
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Webhook receives a signed POST for every event whose type is listed in
+// EventTypes (comma separated). An empty EventTypes subscribes to all events.
+type Webhook struct {
+	gorm.Model
+	ID         uint64 `gorm:"primaryKey;autoIncrement" json:"ID"`
+	Name       string `json:"Name"`
+	URL        string `json:"URL"`
+	Secret     string `json:"Secret,omitempty"`
+	EventTypes string `json:"EventTypes"`
+	Enabled    bool   `json:"Enabled"`
+}
+
+func (w *Webhook) Matches(eventType string) bool {
+	if strings.TrimSpace(w.EventTypes) == "" {
+		return true
+	}
+	for _, t := range strings.Split(w.EventTypes, ",") {
+		if strings.TrimSpace(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Webhook) String() string {
+	return fmt.Sprintf("Webhook{ID: %d, Name: %s, URL: %s, EventTypes: %s, Enabled: %t}",
+		w.ID, w.Name, w.URL, w.EventTypes, w.Enabled)
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type DeliveryStatus string
+
+const (
+	PENDING   DeliveryStatus = "Pending"
+	DELIVERED DeliveryStatus = "Delivered"
+	FAILED    DeliveryStatus = "Failed"
+)
+
+// WebhookDelivery is both the retry queue entry and the delivery log record
+// for one event sent to one webhook.
+type WebhookDelivery struct {
+	gorm.Model
+	ID             uint64         `gorm:"primaryKey;autoIncrement" json:"ID"`
+	WebhookID      uint64         `gorm:"index" json:"WebhookID"`
+	EventType      string         `json:"EventType"`
+	Payload        string         `json:"Payload"`
+	Status         DeliveryStatus `gorm:"index" json:"Status"`
+	Attempts       int            `json:"Attempts"`
+	NextAttemptAt  time.Time      `gorm:"index" json:"NextAttemptAt"`
+	LastAttemptAt  *time.Time     `json:"LastAttemptAt,omitempty"`
+	ResponseStatus int            `json:"ResponseStatus"`
+	LastError      string         `json:"LastError,omitempty"`
+}
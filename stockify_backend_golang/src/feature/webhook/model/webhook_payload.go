@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// WebhookPayload is the JSON body posted to webhook URLs.
+type WebhookPayload struct {
+	Event      string      `json:"event"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Data       interface{} `json:"data"`
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"stockify_backend_golang/src/feature/webhook/model"
+	"time"
+)
+
+type WebhookRepository interface {
+	GetAllWebhooks() []model.Webhook
+	GetWebhookById(id uint64) model.Webhook
+	AddWebhook(webhook model.Webhook)
+	UpdateWebhook(webhook model.Webhook)
+	DeleteWebhookById(id uint64)
+	AddDelivery(delivery *model.WebhookDelivery) error
+	UpdateDelivery(delivery model.WebhookDelivery) error
+	GetDeliveryById(id uint64) model.WebhookDelivery
+	GetDueDeliveries(now time.Time, limit int) []model.WebhookDelivery
+	GetDeliveries(webhookId uint64, limit int) []model.WebhookDelivery
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"log"
+	"stockify_backend_golang/src/common/db"
+	"stockify_backend_golang/src/feature/webhook/model"
+	"time"
+)
+
+func init() {
+	err := db.DB.AutoMigrate(&model.Webhook{}, &model.WebhookDelivery{})
+	if err != nil {
+		log.Fatal("Failed to migrate Webhook tables: " + err.Error())
+	}
+}
+
+type webhookRepository struct{}
+
+func WebhookRepositoryImplementation() WebhookRepository {
+	return &webhookRepository{}
+}
+
+func (r *webhookRepository) GetAllWebhooks() []model.Webhook {
+	var webhooks []model.Webhook
+	db.DB.Find(&webhooks)
+	return webhooks
+}
+
+func (r *webhookRepository) GetWebhookById(id uint64) model.Webhook {
+	var webhook model.Webhook
+	db.DB.First(&webhook, id)
+	return webhook
+}
+
+func (r *webhookRepository) AddWebhook(webhook model.Webhook) {
+	db.DB.Create(&webhook)
+}
+
+func (r *webhookRepository) UpdateWebhook(webhook model.Webhook) {
+	db.DB.Save(&webhook)
+}
+
+func (r *webhookRepository) DeleteWebhookById(id uint64) {
+	db.DB.Delete(&model.Webhook{}, id)
+}
+
+func (r *webhookRepository) AddDelivery(delivery *model.WebhookDelivery) error {
+	return db.DB.Create(delivery).Error
+}
+
+func (r *webhookRepository) UpdateDelivery(delivery model.WebhookDelivery) error {
+	return db.DB.Save(&delivery).Error
+}
+
+func (r *webhookRepository) GetDeliveryById(id uint64) model.WebhookDelivery {
+	var delivery model.WebhookDelivery
+	db.DB.First(&delivery, id)
+	return delivery
+}
+
+func (r *webhookRepository) GetDueDeliveries(now time.Time, limit int) []model.WebhookDelivery {
+	var deliveries []model.WebhookDelivery
+	db.DB.Where("status = ? AND next_attempt_at <= ?", model.PENDING, now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&deliveries)
+	return deliveries
+}
+
+// GetDeliveries returns the most recent deliveries, for every webhook when
+// webhookId is 0.
+func (r *webhookRepository) GetDeliveries(webhookId uint64, limit int) []model.WebhookDelivery {
+	query := db.DB.Order("id DESC")
+	if webhookId != 0 {
+		query = query.Where("webhook_id = ?", webhookId)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var deliveries []model.WebhookDelivery
+	query.Find(&deliveries)
+	return deliveries
+}
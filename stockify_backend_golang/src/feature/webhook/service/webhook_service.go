@@ -0,0 +1,21 @@
+package service
+
+import (
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/webhook/model"
+	"time"
+)
+
+type WebhookService interface {
+	GetAllWebhooks() []model.Webhook
+	GetWebhookById(id uint64) model.Webhook
+	AddWebhook(webhook model.Webhook) error
+	UpdateWebhook(webhook model.Webhook) error
+	DeleteWebhookById(id uint64)
+	GetDeliveries(webhookId uint64, limit int) []model.WebhookDelivery
+	RetryDelivery(id uint64) error
+	HandleEvent(e event.Event)
+	ProcessDueDeliveries(now time.Time)
+	StartDispatcher(interval time.Duration)
+	StopDispatcher()
+}
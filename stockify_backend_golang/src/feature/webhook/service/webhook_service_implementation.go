@@ -0,0 +1,253 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/webhook/model"
+	"stockify_backend_golang/src/feature/webhook/repository"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxAttempts is how many times a delivery is tried before it is marked failed
+	MaxAttempts = 8
+	// BaseRetryDelay doubles after every failed attempt, up to MaxRetryDelay
+	BaseRetryDelay = 30 * time.Second
+	MaxRetryDelay  = time.Hour
+
+	dueBatchSize = 50
+)
+
+type webhookService struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+
+	processing sync.Mutex
+	mu         sync.Mutex
+	stop       chan struct{}
+}
+
+func WebhookServiceImplementation(repo repository.WebhookRepository, client *http.Client) WebhookService {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &webhookService{repo: repo, client: client}
+}
+
+func (s *webhookService) GetAllWebhooks() []model.Webhook {
+	return s.repo.GetAllWebhooks()
+}
+
+func (s *webhookService) GetWebhookById(id uint64) model.Webhook {
+	return s.repo.GetWebhookById(id)
+}
+
+func (s *webhookService) AddWebhook(webhook model.Webhook) error {
+	if err := validateWebhook(webhook); err != nil {
+		return err
+	}
+	s.repo.AddWebhook(webhook)
+	return nil
+}
+
+func (s *webhookService) UpdateWebhook(webhook model.Webhook) error {
+	if err := validateWebhook(webhook); err != nil {
+		return err
+	}
+	existing := s.repo.GetWebhookById(webhook.ID)
+	if existing.ID == 0 {
+		return errors.New("webhook not found")
+	}
+	// Keep the stored secret when the client sends the webhook back without it
+	if webhook.Secret == "" {
+		webhook.Secret = existing.Secret
+	}
+	webhook.CreatedAt = existing.CreatedAt
+	s.repo.UpdateWebhook(webhook)
+	return nil
+}
+
+func (s *webhookService) DeleteWebhookById(id uint64) {
+	s.repo.DeleteWebhookById(id)
+}
+
+func (s *webhookService) GetDeliveries(webhookId uint64, limit int) []model.WebhookDelivery {
+	return s.repo.GetDeliveries(webhookId, limit)
+}
+
+// RetryDelivery puts a delivery back in the queue for an immediate attempt.
+func (s *webhookService) RetryDelivery(id uint64) error {
+	delivery := s.repo.GetDeliveryById(id)
+	if delivery.ID == 0 {
+		return errors.New("delivery not found")
+	}
+	delivery.Status = model.PENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	if err := s.repo.UpdateDelivery(delivery); err != nil {
+		return err
+	}
+	go s.ProcessDueDeliveries(time.Now())
+	return nil
+}
+
+// HandleEvent queues a delivery for every enabled webhook subscribed to the
+// event and kicks off an attempt in the background.
+func (s *webhookService) HandleEvent(e event.Event) {
+	body, err := json.Marshal(model.WebhookPayload{
+		Event:      string(e.Type),
+		OccurredAt: e.OccurredAt,
+		Data:       e.Data,
+	})
+	if err != nil {
+		log.Println("Failed to marshal webhook payload:", err)
+		return
+	}
+	queued := false
+	for _, webhook := range s.repo.GetAllWebhooks() {
+		if !webhook.Enabled || !webhook.Matches(string(e.Type)) {
+			continue
+		}
+		delivery := model.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventType:     string(e.Type),
+			Payload:       string(body),
+			Status:        model.PENDING,
+			NextAttemptAt: e.OccurredAt,
+		}
+		if err := s.repo.AddDelivery(&delivery); err != nil {
+			log.Println("Failed to queue webhook delivery:", err)
+			continue
+		}
+		queued = true
+	}
+	if queued {
+		go s.ProcessDueDeliveries(time.Now())
+	}
+}
+
+// ProcessDueDeliveries attempts every pending delivery whose next attempt is
+// due. Concurrent calls are serialized so a delivery is never sent twice.
+func (s *webhookService) ProcessDueDeliveries(now time.Time) {
+	s.processing.Lock()
+	defer s.processing.Unlock()
+	for _, delivery := range s.repo.GetDueDeliveries(now, dueBatchSize) {
+		webhook := s.repo.GetWebhookById(delivery.WebhookID)
+		if webhook.ID == 0 {
+			delivery.Status = model.FAILED
+			delivery.LastError = "webhook no longer exists"
+			_ = s.repo.UpdateDelivery(delivery)
+			continue
+		}
+		s.attempt(webhook, &delivery, now)
+		if err := s.repo.UpdateDelivery(delivery); err != nil {
+			log.Println("Failed to update webhook delivery:", err)
+		}
+	}
+}
+
+func (s *webhookService) attempt(webhook model.Webhook, delivery *model.WebhookDelivery, now time.Time) {
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+
+	status, err := s.post(webhook, *delivery)
+	delivery.ResponseStatus = status
+	if err == nil {
+		delivery.Status = model.DELIVERED
+		delivery.LastError = ""
+		return
+	}
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= MaxAttempts {
+		delivery.Status = model.FAILED
+		return
+	}
+	delivery.NextAttemptAt = now.Add(RetryDelay(delivery.Attempts))
+}
+
+func (s *webhookService) post(webhook model.Webhook, delivery model.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	request, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Stockify-Webhook")
+	request.Header.Set(EventHeader, delivery.EventType)
+	request.Header.Set(DeliveryHeader, strconv.FormatUint(delivery.ID, 10))
+	if webhook.Secret != "" {
+		request.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// RetryDelay is the wait before the next attempt after the given number of
+// failed attempts: BaseRetryDelay doubled per attempt, capped at MaxRetryDelay.
+func RetryDelay(attempts int) time.Duration {
+	delay := BaseRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= MaxRetryDelay {
+			return MaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// StartDispatcher retries due deliveries every interval. Calling it again
+// restarts the dispatcher with the new interval.
+func (s *webhookService) StartDispatcher(interval time.Duration) {
+	s.StopDispatcher()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stop := make(chan struct{})
+	s.stop = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				s.ProcessDueDeliveries(now)
+			}
+		}
+	}()
+}
+
+func (s *webhookService) StopDispatcher() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+func validateWebhook(webhook model.Webhook) error {
+	parsed, err := url.Parse(webhook.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("webhook URL must be an absolute http or https URL")
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"stockify_backend_golang/src/common/event"
+	"stockify_backend_golang/src/feature/webhook/model"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeWebhookRepository keeps webhooks and deliveries in memory. It is locked
+// because HandleEvent processes deliveries in the background.
+type fakeWebhookRepository struct {
+	mu         sync.Mutex
+	webhooks   []model.Webhook
+	deliveries map[uint64]model.WebhookDelivery
+	nextId     uint64
+}
+
+func newFakeWebhookRepository(webhooks ...model.Webhook) *fakeWebhookRepository {
+	return &fakeWebhookRepository{webhooks: webhooks, deliveries: map[uint64]model.WebhookDelivery{}}
+}
+
+func (r *fakeWebhookRepository) GetAllWebhooks() []model.Webhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]model.Webhook{}, r.webhooks...)
+}
+
+func (r *fakeWebhookRepository) GetWebhookById(id uint64) model.Webhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, webhook := range r.webhooks {
+		if webhook.ID == id {
+			return webhook
+		}
+	}
+	return model.Webhook{}
+}
+
+func (r *fakeWebhookRepository) AddWebhook(webhook model.Webhook)    {}
+func (r *fakeWebhookRepository) UpdateWebhook(webhook model.Webhook) {}
+func (r *fakeWebhookRepository) DeleteWebhookById(id uint64)         {}
+
+func (r *fakeWebhookRepository) AddDelivery(delivery *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextId++
+	delivery.ID = r.nextId
+	r.deliveries[delivery.ID] = *delivery
+	return nil
+}
+
+func (r *fakeWebhookRepository) UpdateDelivery(delivery model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (r *fakeWebhookRepository) GetDeliveryById(id uint64) model.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deliveries[id]
+}
+
+func (r *fakeWebhookRepository) GetDueDeliveries(now time.Time, limit int) []model.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []model.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == model.PENDING && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due
+}
+
+func (r *fakeWebhookRepository) GetDeliveries(webhookId uint64, limit int) []model.WebhookDelivery {
+	return nil
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func TestHandleEventDeliversSignedPayload(t *testing.T) {
+	requests := make(chan receivedRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- receivedRequest{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := newFakeWebhookRepository(
+		model.Webhook{ID: 1, URL: server.URL, Secret: "s3cret", EventTypes: "item.updated, item.deleted", Enabled: true},
+		model.Webhook{ID: 2, URL: server.URL, EventTypes: "item.updated", Enabled: false},
+		model.Webhook{ID: 3, URL: server.URL, EventTypes: "user.updated", Enabled: true},
+	)
+	service := WebhookServiceImplementation(repo, server.Client())
+
+	occurredAt := time.Date(2026, time.May, 4, 9, 30, 0, 0, time.UTC)
+	service.HandleEvent(event.Event{
+		Type:       "item.updated",
+		OccurredAt: occurredAt,
+		Data:       map[string]string{"AssetNo": "PC-001"},
+	})
+
+	var request receivedRequest
+	select {
+	case request = <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the webhook was not called")
+	}
+	if !VerifySignature("s3cret", request.body, request.header.Get(SignatureHeader)) {
+		t.Errorf("signature %q does not match the body", request.header.Get(SignatureHeader))
+	}
+	if got := request.header.Get(EventHeader); got != "item.updated" {
+		t.Errorf("%s = %q", EventHeader, got)
+	}
+	if got := request.header.Get(DeliveryHeader); got != "1" {
+		t.Errorf("%s = %q", DeliveryHeader, got)
+	}
+	if got := request.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	var payload struct {
+		Event      string            `json:"event"`
+		OccurredAt time.Time         `json:"occurredAt"`
+		Data       map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal(request.body, &payload); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if payload.Event != "item.updated" || !payload.OccurredAt.Equal(occurredAt) || payload.Data["AssetNo"] != "PC-001" {
+		t.Errorf("unexpected payload %s", request.body)
+	}
+
+	// The delivery is marked once the background attempt finishes
+	deadline := time.Now().Add(5 * time.Second)
+	for repo.GetDeliveryById(1).Status != model.DELIVERED {
+		if time.Now().After(deadline) {
+			t.Fatalf("delivery status = %s", repo.GetDeliveryById(1).Status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if delivery := repo.GetDeliveryById(1); delivery.Attempts != 1 || delivery.ResponseStatus != http.StatusNoContent {
+		t.Errorf("delivery = %+v", delivery)
+	}
+	select {
+	case extra := <-requests:
+		t.Errorf("a disabled or unsubscribed webhook was called: %s", extra.header.Get(DeliveryHeader))
+	case <-time.After(50 * time.Millisecond):
+	}
+	if len(repo.deliveries) != 1 {
+		t.Errorf("%d deliveries queued, want 1", len(repo.deliveries))
+	}
+}
+
+func TestProcessDueDeliveriesRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	repo := newFakeWebhookRepository(model.Webhook{ID: 1, URL: server.URL, Enabled: true})
+	service := WebhookServiceImplementation(repo, server.Client())
+	now := time.Date(2026, time.May, 4, 9, 30, 0, 0, time.UTC)
+	repo.AddDelivery(&model.WebhookDelivery{WebhookID: 1, EventType: "item.updated", Payload: `{}`, Status: model.PENDING, NextAttemptAt: now})
+
+	steps := []struct {
+		at       time.Time
+		calls    int32
+		attempts int
+		status   model.DeliveryStatus
+		next     time.Time
+	}{
+		{now, 1, 1, model.PENDING, now.Add(30 * time.Second)},
+		// Not due yet, nothing is sent
+		{now.Add(29 * time.Second), 1, 1, model.PENDING, now.Add(30 * time.Second)},
+		{now.Add(30 * time.Second), 2, 2, model.PENDING, now.Add(90 * time.Second)},
+		{now.Add(90 * time.Second), 3, 3, model.DELIVERED, now.Add(90 * time.Second)},
+		// Delivered, nothing is sent again
+		{now.Add(time.Hour), 3, 3, model.DELIVERED, now.Add(90 * time.Second)},
+	}
+	for i, step := range steps {
+		service.ProcessDueDeliveries(step.at)
+		delivery := repo.GetDeliveryById(1)
+		if calls.Load() != step.calls || delivery.Attempts != step.attempts || delivery.Status != step.status ||
+			!delivery.NextAttemptAt.Equal(step.next) {
+			t.Fatalf("step %d: calls %d, attempts %d, status %s, next %s", i, calls.Load(), delivery.Attempts,
+				delivery.Status, delivery.NextAttemptAt.Sub(now))
+		}
+	}
+	if delivery := repo.GetDeliveryById(1); delivery.LastError != "" || delivery.ResponseStatus != http.StatusOK {
+		t.Errorf("delivery = %+v", delivery)
+	}
+}
+
+func TestProcessDueDeliveriesGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	repo := newFakeWebhookRepository(model.Webhook{ID: 1, URL: server.URL, Enabled: true})
+	service := WebhookServiceImplementation(repo, server.Client())
+	now := time.Date(2026, time.May, 4, 9, 30, 0, 0, time.UTC)
+	repo.AddDelivery(&model.WebhookDelivery{WebhookID: 1, Payload: `{}`, Status: model.PENDING, NextAttemptAt: now})
+
+	for i := 0; i < MaxAttempts+2; i++ {
+		service.ProcessDueDeliveries(now.Add(time.Duration(i) * 24 * time.Hour))
+	}
+	delivery := repo.GetDeliveryById(1)
+	if delivery.Status != model.FAILED || delivery.Attempts != MaxAttempts || calls.Load() != MaxAttempts {
+		t.Fatalf("status %s after %d attempts and %d calls", delivery.Status, delivery.Attempts, calls.Load())
+	}
+	if delivery.ResponseStatus != http.StatusBadGateway || delivery.LastError == "" {
+		t.Errorf("delivery = %+v", delivery)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+	for _, test := range tests {
+		if got := RetryDelay(test.attempts); got != test.want {
+			t.Errorf("RetryDelay(%d) = %s, want %s", test.attempts, got, test.want)
+		}
+	}
+}
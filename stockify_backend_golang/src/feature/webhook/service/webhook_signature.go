@@ -0,0 +1,26 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	SignatureHeader = "X-Stockify-Signature"
+	EventHeader     = "X-Stockify-Event"
+	DeliveryHeader  = "X-Stockify-Delivery"
+)
+
+// Sign returns the value of the signature header for body: "sha256=" followed
+// by the hex encoded HMAC-SHA256 of the body keyed with the webhook secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature header value in constant time.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,76 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	"path/filepath"
+	labelmodel "stockify_backend_golang/src/feature/label/model"
+	labelrepository "stockify_backend_golang/src/feature/label/repository"
+	labelservice "stockify_backend_golang/src/feature/label/service"
+	"strings"
+)
+
+var labelRepository = labelrepository.LabelRepositoryImplementation()
+var labelService = labelservice.LabelServiceImplementation(labelRepository, itemService)
+
+// ========== Label Functions ==========
+
+//export GetLabelTemplates
+func GetLabelTemplates() *C.char {
+	return jsonResult(labelService.GetTemplates(), "label templates")
+}
+
+//export GetLabelSettings
+func GetLabelSettings() *C.char {
+	return jsonResult(labelService.GetSettings(), "label settings")
+}
+
+// SaveLabelSettings takes LabelSettings as JSON: the URL template the codes
+// encode and the default template and symbology.
+//
+//export SaveLabelSettings
+func SaveLabelSettings(settingsJSON *C.char) *C.char {
+	var settings labelmodel.LabelSettings
+	if err := json.Unmarshal([]byte(cStringToGo(settingsJSON)), &settings); err != nil {
+		return jsonError("Invalid label settings: " + err.Error())
+	}
+	return jsonStatus(labelService.SaveSettings(settings))
+}
+
+// GenerateLabels takes a LabelRequest as JSON, selecting items by ID or with
+// the same filter as GetFilteredItemsJSON, and writes the label sheets to
+// outPath. It returns the paths of the files written.
+//
+//export GenerateLabels
+func GenerateLabels(requestJSON, outPath *C.char) *C.char {
+	var request labelmodel.LabelRequest
+	if err := json.Unmarshal([]byte(cStringToGo(requestJSON)), &request); err != nil {
+		return jsonError("Invalid label request: " + err.Error())
+	}
+	paths, err := labelService.GenerateLabels(request, cStringToGo(outPath))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(paths, "label files")
+}
+
+// GenerateItemLabel writes the label of a single item with the default
+// settings, on a one-label page so it can go straight to a label printer. The
+// label is a PNG when outPath ends in .png and a PDF otherwise.
+//
+//export GenerateItemLabel
+func GenerateItemLabel(itemId C.ulonglong, outPath *C.char) *C.char {
+	path := cStringToGo(outPath)
+	request := labelmodel.LabelRequest{ItemIDs: []uint64{uint64(itemId)}, Template: labelmodel.SingleLabelTemplate}
+	if strings.EqualFold(filepath.Ext(path), ".png") {
+		request.Format = labelmodel.PNG
+	}
+	paths, err := labelService.GenerateLabels(request, path)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(paths, "label files")
+}
@@ -0,0 +1,65 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	"stockify_backend_golang/src/feature/item/model"
+	lifecyclemodel "stockify_backend_golang/src/feature/lifecycle/model"
+)
+
+// ========== Lifecycle Functions ==========
+
+//export GetStatusTransitions
+func GetStatusTransitions() *C.char {
+	return jsonResult(lifecycleService.GetAllTransitions(), "status transitions")
+}
+
+//export GetAllowedStatusTransitions
+func GetAllowedStatusTransitions(fromStatus *C.char) *C.char {
+	from := model.AssetStatus(cStringToGo(fromStatus))
+	return jsonResult(lifecycleService.GetAllowedTransitions(from), "status transitions")
+}
+
+//export AddStatusTransition
+func AddStatusTransition(transitionJSON *C.char) *C.char {
+	var transition lifecyclemodel.StatusTransition
+	if err := json.Unmarshal([]byte(cStringToGo(transitionJSON)), &transition); err != nil {
+		return jsonError("Invalid status transition: " + err.Error())
+	}
+	transition.ID = 0
+	return jsonStatus(lifecycleService.AddTransition(transition))
+}
+
+//export UpdateStatusTransition
+func UpdateStatusTransition(transitionJSON *C.char) *C.char {
+	var transition lifecyclemodel.StatusTransition
+	if err := json.Unmarshal([]byte(cStringToGo(transitionJSON)), &transition); err != nil {
+		return jsonError("Invalid status transition: " + err.Error())
+	}
+	return jsonStatus(lifecycleService.UpdateTransition(transition))
+}
+
+//export DeleteStatusTransitionById
+func DeleteStatusTransitionById(id C.ulonglong) {
+	lifecycleService.DeleteTransitionById(uint64(id))
+}
+
+//export TransitionItemStatus
+func TransitionItemStatus(itemId C.ulonglong, toStatus, fieldsJSON, note *C.char) *C.char {
+	fields := map[string]string{}
+	if raw := cStringToGo(fieldsJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+			return jsonError("Invalid transition fields: " + err.Error())
+		}
+	}
+	err := itemService.TransitionStatus(uint64(itemId), model.AssetStatus(cStringToGo(toStatus)), fields, cStringToGo(note))
+	return jsonStatus(err)
+}
+
+//export GetItemStatusHistory
+func GetItemStatusHistory(itemId C.ulonglong) *C.char {
+	return jsonResult(lifecycleService.GetHistoryByItemId(uint64(itemId)), "status history")
+}
@@ -0,0 +1,132 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	itemmodel "stockify_backend_golang/src/feature/item/model"
+	loanmodel "stockify_backend_golang/src/feature/loan/model"
+	loanrepository "stockify_backend_golang/src/feature/loan/repository"
+	loanservice "stockify_backend_golang/src/feature/loan/service"
+	"time"
+)
+
+var loanRepository = loanrepository.LoanRepositoryImplementation()
+var loanService = loanservice.LoanServiceImplementation(loanRepository, itemService, userRepository)
+
+// ========== Loan Functions ==========
+
+// Times are passed as Unix seconds.
+
+//export GetLoanById
+func GetLoanById(id C.ulonglong) *C.char {
+	loan := loanService.GetLoanById(uint64(id))
+	if loan.ID == 0 {
+		return jsonError("Loan not found")
+	}
+	return jsonResult(loan, "loan")
+}
+
+// GetItemLoans returns the loans of an item; activeOnly limits them to the
+// reserved and checked out ones.
+//
+//export GetItemLoans
+func GetItemLoans(itemId C.ulonglong, activeOnly C.char) *C.char {
+	id := uint64(itemId)
+	return jsonResult(loanService.GetLoans(loanQuery(&id, nil, activeOnly == 1)), "loans")
+}
+
+// GetUserLoans returns the loans of a user; activeOnly limits them to the
+// reserved and checked out ones.
+//
+//export GetUserLoans
+func GetUserLoans(userId C.ulonglong, activeOnly C.char) *C.char {
+	id := uint64(userId)
+	return jsonResult(loanService.GetLoans(loanQuery(nil, &id, activeOnly == 1)), "loans")
+}
+
+// ReserveItem books an item for a user from start until end, failing with
+// the conflicting booking when the item is taken.
+//
+//export ReserveItem
+func ReserveItem(itemId, userId C.ulonglong, start, end C.longlong, note *C.char) *C.char {
+	loan, err := loanService.Reserve(uint64(itemId), uint64(userId),
+		time.Unix(int64(start), 0), time.Unix(int64(end), 0), cStringToGo(note))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(loan, "loan")
+}
+
+// CheckOutItem hands an item over to a user now without a reservation
+//
+//export CheckOutItem
+func CheckOutItem(itemId, userId C.ulonglong, due C.longlong, note *C.char) *C.char {
+	loan, err := loanService.CheckOut(uint64(itemId), uint64(userId), time.Unix(int64(due), 0), cStringToGo(note))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(loan, "loan")
+}
+
+// CheckOutReservation hands a reserved item over now. A due of 0 keeps the
+// end of the reservation as due date.
+//
+//export CheckOutReservation
+func CheckOutReservation(id C.ulonglong, due C.longlong) *C.char {
+	var dueTime *time.Time
+	if due != 0 {
+		t := time.Unix(int64(due), 0)
+		dueTime = &t
+	}
+	loan, err := loanService.CheckOutReservation(uint64(id), dueTime)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(loan, "loan")
+}
+
+//export CheckInLoan
+func CheckInLoan(id C.ulonglong) *C.char {
+	loan, err := loanService.CheckIn(uint64(id))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(loan, "loan")
+}
+
+//export CancelReservation
+func CancelReservation(id C.ulonglong) *C.char {
+	loan, err := loanService.Cancel(uint64(id))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(loan, "loan")
+}
+
+//export GetOverdueLoans
+func GetOverdueLoans() *C.char {
+	return jsonResult(loanService.GetOverdueLoans(), "loans")
+}
+
+// GetAvailableLoanItems returns the items of a device type free to lend
+// from start until end.
+//
+//export GetAvailableLoanItems
+func GetAvailableLoanItems(deviceType *C.char, start, end C.longlong) *C.char {
+	items, err := loanService.GetAvailableItems(itemmodel.DeviceType(cStringToGo(deviceType)),
+		time.Unix(int64(start), 0), time.Unix(int64(end), 0))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(items, "items")
+}
+
+func loanQuery(itemId, userId *uint64, activeOnly bool) loanmodel.LoanQueryParams {
+	params := loanmodel.LoanQueryParams{ItemID: itemId, UserID: userId}
+	if activeOnly {
+		params.Statuses = loanmodel.ActiveLoanStatuses
+	}
+	return params
+}
@@ -0,0 +1,91 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	locationmodel "stockify_backend_golang/src/feature/location/model"
+	locationrepository "stockify_backend_golang/src/feature/location/repository"
+	locationservice "stockify_backend_golang/src/feature/location/service"
+)
+
+var locationRepository = locationrepository.LocationRepositoryImplementation()
+var locationService = locationservice.LocationServiceImplementation(locationRepository, itemService, userService)
+
+// ========== Location Functions ==========
+
+//export GetAllLocations
+func GetAllLocations() *C.char {
+	return jsonResult(locationService.GetAllLocations(), "locations")
+}
+
+//export GetLocationById
+func GetLocationById(id C.ulonglong) *C.char {
+	location := locationService.GetLocationById(uint64(id))
+	if location.ID == 0 {
+		return jsonError("Location not found")
+	}
+	return jsonResult(location, "location")
+}
+
+// GetLocationTree returns the location hierarchy with the number of items and
+// users at each location and in total below it.
+//
+//export GetLocationTree
+func GetLocationTree() *C.char {
+	tree, err := locationService.GetLocationTree()
+	if err != nil {
+		return jsonError("Failed to count inventory by location")
+	}
+	return jsonResult(tree, "location tree")
+}
+
+//export AddLocation
+func AddLocation(name, kind *C.char, parentId C.ulonglong) *C.char {
+	location := locationmodel.Location{
+		Name:     cStringToGo(name),
+		Kind:     locationmodel.LocationKind(cStringToGo(kind)),
+		ParentID: locationIdOrNil(parentId),
+	}
+	return jsonStatus(locationService.AddLocation(location))
+}
+
+//export UpdateLocation
+func UpdateLocation(id C.ulonglong, name, kind *C.char, parentId C.ulonglong) *C.char {
+	location := locationmodel.Location{
+		ID:       uint64(id),
+		Name:     cStringToGo(name),
+		Kind:     locationmodel.LocationKind(cStringToGo(kind)),
+		ParentID: locationIdOrNil(parentId),
+	}
+	return jsonStatus(locationService.UpdateLocation(location))
+}
+
+//export DeleteLocationById
+func DeleteLocationById(id C.ulonglong) *C.char {
+	return jsonStatus(locationService.DeleteLocationById(uint64(id)))
+}
+
+// SetItemLocation moves an item to a location, or clears it when locationId is 0.
+//
+//export SetItemLocation
+func SetItemLocation(itemId, locationId C.ulonglong) *C.char {
+	return jsonStatus(locationService.SetItemLocation(uint64(itemId), locationIdOrNil(locationId)))
+}
+
+// SetUserLocation moves a user to a location, or clears it when locationId is 0.
+//
+//export SetUserLocation
+func SetUserLocation(userId, locationId C.ulonglong) *C.char {
+	return jsonStatus(locationService.SetUserLocation(uint64(userId), locationIdOrNil(locationId)))
+}
+
+// Maps the 0 used for "no location" over FFI to nil
+func locationIdOrNil(id C.ulonglong) *uint64 {
+	if id == 0 {
+		return nil
+	}
+	value := uint64(id)
+	return &value
+}
@@ -7,9 +7,12 @@ import "C"
 import (
 	"encoding/json"
 	"log"
+	devicetyperepository "stockify_backend_golang/src/feature/devicetype/repository"
 	"stockify_backend_golang/src/feature/item/model"
 	itemrepository "stockify_backend_golang/src/feature/item/repository"
 	itemservice "stockify_backend_golang/src/feature/item/service"
+	lifecyclerepository "stockify_backend_golang/src/feature/lifecycle/repository"
+	lifecycleservice "stockify_backend_golang/src/feature/lifecycle/service"
 	usermodel "stockify_backend_golang/src/feature/user/model"
 	userrepository "stockify_backend_golang/src/feature/user/repository"
 	userservice "stockify_backend_golang/src/feature/user/service"
@@ -17,8 +20,11 @@ import (
 	"unsafe"
 )
 
+var deviceTypeRepository = devicetyperepository.DeviceTypeRepositoryImplementation()
 var itemRepository = itemrepository.ItemRepositoryImplementation()
-var itemService = itemservice.ItemServiceImplementation(itemRepository)
+var lifecycleRepository = lifecyclerepository.LifecycleRepositoryImplementation()
+var lifecycleService = lifecycleservice.LifecycleServiceImplementation(lifecycleRepository)
+var itemService = itemservice.ItemServiceImplementation(itemRepository, deviceTypeRepository, customFieldRepository, lifecycleService)
 var userRepository = userrepository.UserRepositoryImplementation()
 var userService = userservice.UserServiceImplementation(userRepository)
 
@@ -74,6 +80,7 @@ func UpdateUser(id C.ulonglong, userName, designation, sapId, ipPhone, roomNo, f
 		RoomNo:      cStringOrNil(roomNo),
 		Floor:       cStringOrNil(floor),
 	}
+	keepUserColumns(&user)
 	userService.UpdateUser(user)
 }
 
@@ -100,6 +107,24 @@ func GetFilteredUsers(search, sortBy, sortOrder *C.char) *C.char {
 	return C.CString(string(jsonData))
 }
 
+// GetFilteredUsersJSON takes the full UserQueryParams as JSON, including the
+// filters that have no positional argument in GetFilteredUsers.
+//
+//export GetFilteredUsersJSON
+func GetFilteredUsersJSON(paramsJSON *C.char) *C.char {
+	var params usermodel.UserQueryParams
+	if raw := cStringToGo(paramsJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &params); err != nil {
+			return jsonError("Invalid filter params: " + err.Error())
+		}
+	}
+	users, err := userService.GetFilteredUsers(params)
+	if err != nil {
+		return jsonError("Failed to get filtered users")
+	}
+	return jsonResult(users, "users")
+}
+
 // ========== Helper Functions ==========
 
 // Converts C string to Go *string, returns nil if empty
@@ -118,6 +143,45 @@ func jsonError(message string) *C.char {
 	return C.CString(string(jsonData))
 }
 
+// Marshals v to a C string, or returns a JSON error naming what failed
+func jsonResult(v interface{}, name string) *C.char {
+	jsonData, err := json.Marshal(v)
+	if err != nil {
+		return jsonError("Failed to marshal " + name)
+	}
+	return C.CString(string(jsonData))
+}
+
+// Returns {"success":true} or the JSON error for err
+func jsonStatus(err error) *C.char {
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return C.CString(`{"success":true}`)
+}
+
+// Copies the columns UpdateItemFull has no argument for from the stored item,
+// so saving the edit form does not clear them
+func keepItemColumns(item *model.Item) {
+	existing := itemService.GetItemById(item.ID)
+	item.CreatedAt = existing.CreatedAt
+	item.LocationID = existing.LocationID
+	item.VendorID = existing.VendorID
+	item.PurchaseOrderID = existing.PurchaseOrderID
+	item.PurchasePrice = existing.PurchasePrice
+	item.Currency = existing.Currency
+	item.InvoiceNo = existing.InvoiceNo
+	item.ParentID = existing.ParentID
+}
+
+// Same as keepItemColumns for UpdateUser
+func keepUserColumns(user *usermodel.User) {
+	existing := userService.GetUserById(user.ID)
+	user.CreatedAt = existing.CreatedAt
+	user.LocationID = existing.LocationID
+	user.OrgUnitID = existing.OrgUnitID
+}
+
 // ========== Item Functions ==========
 
 //export AddItemFull
@@ -137,7 +201,7 @@ func AddItemFull(
 	switchPort *C.char,
 	switchIpAddress *C.char,
 	assignedToID C.ulonglong,
-) {
+) *C.char {
 	var receivedTime *time.Time
 	if int64(receivedDate) > 0 {
 		t := time.Unix(int64(receivedDate), 0)
@@ -166,7 +230,7 @@ func AddItemFull(
 		SwitchIpAddress: cStringOrNil(switchIpAddress),
 		AssignedToID:    assignedTo,
 	}
-	itemService.AddItem(item)
+	return jsonStatus(itemService.AddItem(item))
 }
 
 //export GetAllItems
@@ -210,7 +274,7 @@ func UpdateItemFull(
 	switchPort *C.char,
 	switchIpAddress *C.char,
 	assignedToID C.ulonglong,
-) {
+) *C.char {
 	var receivedTime *time.Time
 	if int64(receivedDate) > 0 {
 		t := time.Unix(int64(receivedDate), 0)
@@ -240,8 +304,9 @@ func UpdateItemFull(
 		SwitchIpAddress: cStringOrNil(switchIpAddress),
 		AssignedToID:    assignedTo,
 	}
+	keepItemColumns(&item)
 
-	itemService.UpdateItem(item)
+	return jsonStatus(itemService.UpdateItem(item))
 }
 
 //export DeleteItemById
@@ -317,6 +382,24 @@ func GetFilteredItems(
 	return C.CString(string(jsonBytes))
 }
 
+// GetFilteredItemsJSON takes the full ItemFilterParams as JSON, including the
+// filters that have no positional argument in GetFilteredItems.
+//
+//export GetFilteredItemsJSON
+func GetFilteredItemsJSON(paramsJSON *C.char) *C.char {
+	var params model.ItemFilterParams
+	if raw := cStringToGo(paramsJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &params); err != nil {
+			return jsonError("Invalid filter params: " + err.Error())
+		}
+	}
+	items, err := itemService.GetFilteredItems(params)
+	if err != nil {
+		return jsonError("Failed to get filtered items")
+	}
+	return jsonResult(items, "items")
+}
+
 //export FreeCString
 func FreeCString(str *C.char) {
 	C.free(unsafe.Pointer(str))
@@ -0,0 +1,115 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	maintenancemodel "stockify_backend_golang/src/feature/maintenance/model"
+	maintenancerepository "stockify_backend_golang/src/feature/maintenance/repository"
+	maintenanceservice "stockify_backend_golang/src/feature/maintenance/service"
+	"time"
+)
+
+var maintenanceRepository = maintenancerepository.MaintenanceRepositoryImplementation()
+var maintenanceService = maintenanceservice.MaintenanceServiceImplementation(maintenanceRepository, itemService, lifecycleService)
+
+// ========== Maintenance Functions ==========
+
+//export GetMaintenanceTicketById
+func GetMaintenanceTicketById(id C.ulonglong) *C.char {
+	ticket := maintenanceService.GetTicketById(uint64(id))
+	if ticket.ID == 0 {
+		return jsonError("Maintenance ticket not found")
+	}
+	return jsonResult(ticket, "maintenance ticket")
+}
+
+//export GetItemMaintenanceTickets
+func GetItemMaintenanceTickets(itemId C.ulonglong) *C.char {
+	return jsonResult(maintenanceService.GetTicketsByItemId(uint64(itemId)), "maintenance tickets")
+}
+
+//export GetOpenMaintenanceTickets
+func GetOpenMaintenanceTickets() *C.char {
+	return jsonResult(maintenanceService.GetOpenTickets(), "maintenance tickets")
+}
+
+// OpenMaintenanceTicket takes a MaintenanceTicket as JSON. Opening a repair
+// moves the item to In Repair.
+//
+//export OpenMaintenanceTicket
+func OpenMaintenanceTicket(ticketJSON *C.char) *C.char {
+	var ticket maintenancemodel.MaintenanceTicket
+	if err := json.Unmarshal([]byte(cStringToGo(ticketJSON)), &ticket); err != nil {
+		return jsonError("Invalid maintenance ticket: " + err.Error())
+	}
+	ticket.ID = 0
+	return jsonStatus(maintenanceService.OpenTicket(ticket))
+}
+
+//export UpdateMaintenanceTicket
+func UpdateMaintenanceTicket(ticketJSON *C.char) *C.char {
+	var ticket maintenancemodel.MaintenanceTicket
+	if err := json.Unmarshal([]byte(cStringToGo(ticketJSON)), &ticket); err != nil {
+		return jsonError("Invalid maintenance ticket: " + err.Error())
+	}
+	return jsonStatus(maintenanceService.UpdateTicket(ticket))
+}
+
+// CloseMaintenanceTicket takes a TicketClosure as JSON. Closing a repair puts
+// the item back to the status it had before.
+//
+//export CloseMaintenanceTicket
+func CloseMaintenanceTicket(id C.ulonglong, closureJSON *C.char) *C.char {
+	var closure maintenancemodel.TicketClosure
+	if err := json.Unmarshal([]byte(cStringToGo(closureJSON)), &closure); err != nil {
+		return jsonError("Invalid ticket closure: " + err.Error())
+	}
+	return jsonStatus(maintenanceService.CloseTicket(uint64(id), closure))
+}
+
+//export DeleteMaintenanceTicketById
+func DeleteMaintenanceTicketById(id C.ulonglong) *C.char {
+	return jsonStatus(maintenanceService.DeleteTicketById(uint64(id)))
+}
+
+//export GetMaintenanceSchedules
+func GetMaintenanceSchedules() *C.char {
+	return jsonResult(maintenanceService.GetAllSchedules(), "maintenance schedules")
+}
+
+//export AddMaintenanceSchedule
+func AddMaintenanceSchedule(scheduleJSON *C.char) *C.char {
+	// A schedule is enabled unless the JSON says otherwise
+	schedule := maintenancemodel.MaintenanceSchedule{Enabled: true}
+	if err := json.Unmarshal([]byte(cStringToGo(scheduleJSON)), &schedule); err != nil {
+		return jsonError("Invalid maintenance schedule: " + err.Error())
+	}
+	schedule.ID = 0
+	return jsonStatus(maintenanceService.AddSchedule(schedule))
+}
+
+//export UpdateMaintenanceSchedule
+func UpdateMaintenanceSchedule(scheduleJSON *C.char) *C.char {
+	var schedule maintenancemodel.MaintenanceSchedule
+	if err := json.Unmarshal([]byte(cStringToGo(scheduleJSON)), &schedule); err != nil {
+		return jsonError("Invalid maintenance schedule: " + err.Error())
+	}
+	return jsonStatus(maintenanceService.UpdateSchedule(schedule))
+}
+
+//export DeleteMaintenanceScheduleById
+func DeleteMaintenanceScheduleById(id C.ulonglong) {
+	maintenanceService.DeleteScheduleById(uint64(id))
+}
+
+//export GetOverdueMaintenance
+func GetOverdueMaintenance() *C.char {
+	overdue, err := maintenanceService.GetOverdueMaintenance(time.Now())
+	if err != nil {
+		return jsonError("Failed to check maintenance schedules")
+	}
+	return jsonResult(overdue, "overdue maintenance")
+}
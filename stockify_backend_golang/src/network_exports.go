@@ -0,0 +1,86 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"os"
+	networkmodel "stockify_backend_golang/src/feature/network/model"
+	networkrepository "stockify_backend_golang/src/feature/network/repository"
+	networkservice "stockify_backend_golang/src/feature/network/service"
+	"strings"
+)
+
+var networkRepository = networkrepository.NetworkRepositoryImplementation()
+var networkService = networkservice.NetworkServiceImplementation(networkRepository, itemService, deviceTypeRepository)
+
+// ========== Network Functions ==========
+
+// GetDuplicateAddresses returns the IP addresses, MAC addresses and host names
+// used by more than one item
+//
+//export GetDuplicateAddresses
+func GetDuplicateAddresses() *C.char {
+	duplicates, err := networkService.GetDuplicateAddresses()
+	if err != nil {
+		return jsonError("Failed to load network items")
+	}
+	return jsonResult(duplicates, "duplicate addresses")
+}
+
+// GetSwitchPortMap returns the devices patched into the switch item, by port
+//
+//export GetSwitchPortMap
+func GetSwitchPortMap(switchId C.ulonglong) *C.char {
+	portMap, err := networkService.GetSwitchPortMap(uint64(switchId))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(portMap, "switch port map")
+}
+
+// GetNetworkOverview returns the port map of every switch and the devices
+// patched into a switch that is not in the inventory
+//
+//export GetNetworkOverview
+func GetNetworkOverview() *C.char {
+	overview, err := networkService.GetNetworkOverview()
+	if err != nil {
+		return jsonError("Failed to load network items")
+	}
+	return jsonResult(overview, "network overview")
+}
+
+// NormalizeNetworkAddresses rewrites the MAC, IP and host name fields of
+// existing items in their normalized form. New and updated items are
+// normalized on save.
+//
+//export NormalizeNetworkAddresses
+func NormalizeNetworkAddresses() *C.char {
+	changed, err := networkService.NormalizeAllAddresses()
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(map[string]int{"Changed": changed}, "result")
+}
+
+// ReconcileNetworkDiscovery reads a discovery file, an ARP table ("arp"), a
+// dhcpd lease file ("dhcpd") or nmap XML ("nmap"), and returns a
+// ReconciliationReport. With apply set the mismatched addresses are written
+// to the items.
+//
+//export ReconcileNetworkDiscovery
+func ReconcileNetworkDiscovery(path *C.char, source *C.char, apply C.int) *C.char {
+	file, err := os.Open(cStringToGo(path))
+	if err != nil {
+		return jsonError("Failed to open discovery file: " + err.Error())
+	}
+	defer file.Close()
+	discoverySource := networkmodel.DiscoverySource(strings.ToLower(cStringToGo(source)))
+	report, err := networkService.ReconcileDiscovery(discoverySource, file, apply != 0)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(report, "reconciliation report")
+}
@@ -0,0 +1,74 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	notemodel "stockify_backend_golang/src/feature/note/model"
+	noterepository "stockify_backend_golang/src/feature/note/repository"
+	noteservice "stockify_backend_golang/src/feature/note/service"
+)
+
+var noteRepository = noterepository.NoteRepositoryImplementation()
+var noteService = noteservice.NoteServiceImplementation(noteRepository, itemService, userRepository)
+
+// ========== Note Functions ==========
+
+// GetItemNotes returns the notes on an item as threads, oldest first
+//
+//export GetItemNotes
+func GetItemNotes(itemId C.ulonglong) *C.char {
+	return jsonResult(noteService.GetItemNotes(uint64(itemId)), "notes")
+}
+
+// GetUserNotes returns the notes on a user as threads, oldest first
+//
+//export GetUserNotes
+func GetUserNotes(userId C.ulonglong) *C.char {
+	return jsonResult(noteService.GetUserNotes(uint64(userId)), "notes")
+}
+
+// AddNote takes a Note as JSON with Body, Author and either ItemID, UserID
+// or, for a reply, ReplyToID. Returns the added note.
+//
+//export AddNote
+func AddNote(noteJSON *C.char) *C.char {
+	var note notemodel.Note
+	if err := json.Unmarshal([]byte(cStringToGo(noteJSON)), &note); err != nil {
+		return jsonError("Invalid note: " + err.Error())
+	}
+	added, err := noteService.AddNote(note)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(added, "note")
+}
+
+// GetNoteRevisions returns the earlier texts of an edited note, oldest first
+//
+//export GetNoteRevisions
+func GetNoteRevisions(id C.ulonglong) *C.char {
+	revisions, err := noteService.GetNoteRevisions(uint64(id))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(revisions, "note revisions")
+}
+
+//export UpdateNote
+func UpdateNote(id C.ulonglong, body *C.char) *C.char {
+	note, err := noteService.UpdateNote(uint64(id), cStringToGo(body))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(note, "note")
+}
+
+// DeleteNoteById deletes a note and the replies to it
+//
+//export DeleteNoteById
+func DeleteNoteById(id C.ulonglong) *C.char {
+	return jsonStatus(noteService.DeleteNoteById(uint64(id)))
+}
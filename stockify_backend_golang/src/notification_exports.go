@@ -0,0 +1,99 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	"stockify_backend_golang/src/feature/item/model"
+	notificationmodel "stockify_backend_golang/src/feature/notification/model"
+	notificationrepository "stockify_backend_golang/src/feature/notification/repository"
+	notificationservice "stockify_backend_golang/src/feature/notification/service"
+	"time"
+)
+
+var notificationRepository = notificationrepository.NotificationRepositoryImplementation()
+var notificationService = notificationservice.NotificationServiceImplementation(notificationRepository, itemRepository)
+
+// ========== Notification Functions ==========
+
+//export GetPendingNotifications
+func GetPendingNotifications() *C.char {
+	return jsonResult(notificationService.GetPendingNotifications(time.Now()), "notifications")
+}
+
+//export GetAllNotificationRules
+func GetAllNotificationRules() *C.char {
+	return jsonResult(notificationService.GetAllRules(), "notification rules")
+}
+
+//export AddNotificationRule
+func AddNotificationRule(deviceType *C.char, thresholdDays C.int, enabled C.char) *C.char {
+	rule := notificationmodel.NotificationRule{
+		DeviceType:    deviceTypeOrNil(deviceType),
+		ThresholdDays: int(thresholdDays),
+		Enabled:       enabled == 1,
+	}
+	return jsonStatus(notificationService.AddRule(rule))
+}
+
+//export UpdateNotificationRule
+func UpdateNotificationRule(id C.ulonglong, deviceType *C.char, thresholdDays C.int, enabled C.char) *C.char {
+	rule := notificationmodel.NotificationRule{
+		ID:            uint64(id),
+		DeviceType:    deviceTypeOrNil(deviceType),
+		ThresholdDays: int(thresholdDays),
+		Enabled:       enabled == 1,
+	}
+	// The positional arguments have no saved search, keep the rule's own
+	rule.SavedSearchID = notificationService.GetRuleById(rule.ID).SavedSearchID
+	return jsonStatus(notificationService.UpdateRule(rule))
+}
+
+// AddNotificationRuleJSON takes the full NotificationRule as JSON, including
+// the SavedSearchID that has no positional argument in AddNotificationRule.
+//
+//export AddNotificationRuleJSON
+func AddNotificationRuleJSON(ruleJSON *C.char) *C.char {
+	// A rule is enabled unless the JSON says otherwise
+	rule := notificationmodel.NotificationRule{Enabled: true}
+	if err := json.Unmarshal([]byte(cStringToGo(ruleJSON)), &rule); err != nil {
+		return jsonError("Invalid notification rule: " + err.Error())
+	}
+	return jsonStatus(notificationService.AddRule(rule))
+}
+
+//export UpdateNotificationRuleJSON
+func UpdateNotificationRuleJSON(ruleJSON *C.char) *C.char {
+	var rule notificationmodel.NotificationRule
+	if err := json.Unmarshal([]byte(cStringToGo(ruleJSON)), &rule); err != nil {
+		return jsonError("Invalid notification rule: " + err.Error())
+	}
+	return jsonStatus(notificationService.UpdateRule(rule))
+}
+
+//export DeleteNotificationRuleById
+func DeleteNotificationRuleById(id C.ulonglong) {
+	notificationService.DeleteRuleById(uint64(id))
+}
+
+//export DismissNotification
+func DismissNotification(itemId C.ulonglong, thresholdDays C.int) *C.char {
+	return jsonStatus(notificationService.DismissNotification(uint64(itemId), int(thresholdDays)))
+}
+
+//export SnoozeNotification
+func SnoozeNotification(itemId C.ulonglong, thresholdDays C.int, until C.longlong) *C.char {
+	untilTime := time.Unix(int64(until), 0)
+	return jsonStatus(notificationService.SnoozeNotification(uint64(itemId), int(thresholdDays), untilTime))
+}
+
+func deviceTypeOrNil(cStr *C.char) *model.DeviceType {
+	s := cStringToGo(cStr)
+	if s == "" {
+		return nil
+	}
+	dt := model.DeviceType(s)
+	return &dt
+}
@@ -0,0 +1,62 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	offboardingmodel "stockify_backend_golang/src/feature/offboarding/model"
+	offboardingrepository "stockify_backend_golang/src/feature/offboarding/repository"
+	offboardingservice "stockify_backend_golang/src/feature/offboarding/service"
+)
+
+var offboardingRepository = offboardingrepository.OffboardingRepositoryImplementation()
+var offboardingService = offboardingservice.OffboardingServiceImplementation(
+	offboardingRepository, itemService, userService, lifecycleService, loanService)
+
+// ========== Offboarding Functions ==========
+
+// GetOffboardingPlan lists the items and loans of a leaving user, to be
+// answered with an OffboardingRequest.
+//
+//export GetOffboardingPlan
+func GetOffboardingPlan(userId C.ulonglong) *C.char {
+	plan, err := offboardingService.GetOffboardingPlan(uint64(userId))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(plan, "offboarding plan")
+}
+
+// OffboardUser takes an OffboardingRequest as JSON with a disposition for
+// every item of the plan, hands the items on, deactivates the user and
+// returns the recorded offboarding. Unlike DeleteUserById it leaves no item
+// assigned to a deleted user.
+//
+//export OffboardUser
+func OffboardUser(requestJSON *C.char) *C.char {
+	var request offboardingmodel.OffboardingRequest
+	if err := json.Unmarshal([]byte(cStringToGo(requestJSON)), &request); err != nil {
+		return jsonError("Invalid offboarding request: " + err.Error())
+	}
+	offboarding, err := offboardingService.Offboard(request)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(offboarding, "offboarding")
+}
+
+//export GetAllOffboardings
+func GetAllOffboardings() *C.char {
+	return jsonResult(offboardingService.GetAllOffboardings(), "offboardings")
+}
+
+//export GetOffboardingById
+func GetOffboardingById(id C.ulonglong) *C.char {
+	offboarding := offboardingService.GetOffboardingById(uint64(id))
+	if offboarding.ID == 0 {
+		return jsonError("Offboarding not found")
+	}
+	return jsonResult(offboarding, "offboarding")
+}
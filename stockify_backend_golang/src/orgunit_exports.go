@@ -0,0 +1,84 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	orgunitmodel "stockify_backend_golang/src/feature/orgunit/model"
+	orgunitrepository "stockify_backend_golang/src/feature/orgunit/repository"
+	orgunitservice "stockify_backend_golang/src/feature/orgunit/service"
+)
+
+var orgUnitRepository = orgunitrepository.OrgUnitRepositoryImplementation()
+var orgUnitService = orgunitservice.OrgUnitServiceImplementation(orgUnitRepository, userService)
+
+// ========== Org Unit Functions ==========
+
+//export GetAllOrgUnits
+func GetAllOrgUnits() *C.char {
+	return jsonResult(orgUnitService.GetAllOrgUnits(), "org units")
+}
+
+//export GetOrgUnitById
+func GetOrgUnitById(id C.ulonglong) *C.char {
+	unit := orgUnitService.GetOrgUnitById(uint64(id))
+	if unit.ID == 0 {
+		return jsonError("Org unit not found")
+	}
+	return jsonResult(unit, "org unit")
+}
+
+// GetOrgUnitTree returns the org units with the users, item count and item
+// value of each unit and in total below it.
+//
+//export GetOrgUnitTree
+func GetOrgUnitTree() *C.char {
+	tree, err := orgUnitService.GetOrgUnitTree()
+	if err != nil {
+		return jsonError("Failed to roll up items by org unit")
+	}
+	return jsonResult(tree, "org unit tree")
+}
+
+//export AddOrgUnit
+func AddOrgUnit(name, code *C.char, parentId C.ulonglong) *C.char {
+	unit := orgunitmodel.OrgUnit{
+		Name:     cStringToGo(name),
+		Code:     cStringOrNil(code),
+		ParentID: orgUnitIdOrNil(parentId),
+	}
+	return jsonStatus(orgUnitService.AddOrgUnit(unit))
+}
+
+//export UpdateOrgUnit
+func UpdateOrgUnit(id C.ulonglong, name, code *C.char, parentId C.ulonglong) *C.char {
+	unit := orgunitmodel.OrgUnit{
+		ID:       uint64(id),
+		Name:     cStringToGo(name),
+		Code:     cStringOrNil(code),
+		ParentID: orgUnitIdOrNil(parentId),
+	}
+	return jsonStatus(orgUnitService.UpdateOrgUnit(unit))
+}
+
+//export DeleteOrgUnitById
+func DeleteOrgUnitById(id C.ulonglong) *C.char {
+	return jsonStatus(orgUnitService.DeleteOrgUnitById(uint64(id)))
+}
+
+// SetUserOrgUnit moves a user to an org unit, or clears it when orgUnitId is 0.
+//
+//export SetUserOrgUnit
+func SetUserOrgUnit(userId, orgUnitId C.ulonglong) *C.char {
+	return jsonStatus(orgUnitService.SetUserOrgUnit(uint64(userId), orgUnitIdOrNil(orgUnitId)))
+}
+
+// Maps the 0 used for "no org unit" over FFI to nil
+func orgUnitIdOrNil(id C.ulonglong) *uint64 {
+	if id == 0 {
+		return nil
+	}
+	value := uint64(id)
+	return &value
+}
@@ -0,0 +1,138 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	"os"
+	purchasingmodel "stockify_backend_golang/src/feature/purchasing/model"
+	purchasingrepository "stockify_backend_golang/src/feature/purchasing/repository"
+	purchasingservice "stockify_backend_golang/src/feature/purchasing/service"
+)
+
+var purchasingRepository = purchasingrepository.PurchasingRepositoryImplementation()
+var purchasingService = purchasingservice.PurchasingServiceImplementation(purchasingRepository, itemService)
+
+// ========== Vendor Functions ==========
+
+//export GetAllVendors
+func GetAllVendors() *C.char {
+	return jsonResult(purchasingService.GetAllVendors(), "vendors")
+}
+
+//export GetVendorById
+func GetVendorById(id C.ulonglong) *C.char {
+	vendor := purchasingService.GetVendorById(uint64(id))
+	if vendor.ID == 0 {
+		return jsonError("Vendor not found")
+	}
+	return jsonResult(vendor, "vendor")
+}
+
+//export AddVendor
+func AddVendor(vendorJSON *C.char) *C.char {
+	var vendor purchasingmodel.Vendor
+	if err := json.Unmarshal([]byte(cStringToGo(vendorJSON)), &vendor); err != nil {
+		return jsonError("Invalid vendor: " + err.Error())
+	}
+	vendor.ID = 0
+	return jsonStatus(purchasingService.AddVendor(vendor))
+}
+
+//export UpdateVendor
+func UpdateVendor(vendorJSON *C.char) *C.char {
+	var vendor purchasingmodel.Vendor
+	if err := json.Unmarshal([]byte(cStringToGo(vendorJSON)), &vendor); err != nil {
+		return jsonError("Invalid vendor: " + err.Error())
+	}
+	return jsonStatus(purchasingService.UpdateVendor(vendor))
+}
+
+//export DeleteVendorById
+func DeleteVendorById(id C.ulonglong) *C.char {
+	return jsonStatus(purchasingService.DeleteVendorById(uint64(id)))
+}
+
+// ========== Purchase Order Functions ==========
+
+//export GetAllPurchaseOrders
+func GetAllPurchaseOrders() *C.char {
+	return jsonResult(purchasingService.GetAllPurchaseOrders(), "purchase orders")
+}
+
+//export GetPurchaseOrderById
+func GetPurchaseOrderById(id C.ulonglong) *C.char {
+	order := purchasingService.GetPurchaseOrderById(uint64(id))
+	if order.ID == 0 {
+		return jsonError("Purchase order not found")
+	}
+	return jsonResult(order, "purchase order")
+}
+
+//export AddPurchaseOrder
+func AddPurchaseOrder(orderJSON *C.char) *C.char {
+	var order purchasingmodel.PurchaseOrder
+	if err := json.Unmarshal([]byte(cStringToGo(orderJSON)), &order); err != nil {
+		return jsonError("Invalid purchase order: " + err.Error())
+	}
+	order.ID = 0
+	return jsonStatus(purchasingService.AddPurchaseOrder(order))
+}
+
+//export UpdatePurchaseOrder
+func UpdatePurchaseOrder(orderJSON *C.char) *C.char {
+	var order purchasingmodel.PurchaseOrder
+	if err := json.Unmarshal([]byte(cStringToGo(orderJSON)), &order); err != nil {
+		return jsonError("Invalid purchase order: " + err.Error())
+	}
+	return jsonStatus(purchasingService.UpdatePurchaseOrder(order))
+}
+
+//export DeletePurchaseOrderById
+func DeletePurchaseOrderById(id C.ulonglong) *C.char {
+	return jsonStatus(purchasingService.DeletePurchaseOrderById(uint64(id)))
+}
+
+// ========== Item Purchase Functions ==========
+
+// SetItemPurchase records vendor, purchase order, price, currency and invoice
+// number of an item, given as an ItemPurchase JSON object.
+//
+//export SetItemPurchase
+func SetItemPurchase(itemId C.ulonglong, purchaseJSON *C.char) *C.char {
+	var purchase purchasingmodel.ItemPurchase
+	if err := json.Unmarshal([]byte(cStringToGo(purchaseJSON)), &purchase); err != nil {
+		return jsonError("Invalid item purchase: " + err.Error())
+	}
+	return jsonStatus(purchasingService.SetItemPurchase(uint64(itemId), purchase))
+}
+
+// GetSpend totals purchase prices by "vendor", "device_type" or "year".
+//
+//export GetSpend
+func GetSpend(grouping *C.char) *C.char {
+	rows, err := purchasingService.GetSpend(purchasingmodel.SpendGrouping(cStringToGo(grouping)))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(rows, "spend")
+}
+
+// ImportPurchasesCSV applies the purchase data in a CSV file to the items it
+// names by asset number and returns a PurchaseImportResult.
+//
+//export ImportPurchasesCSV
+func ImportPurchasesCSV(path *C.char) *C.char {
+	file, err := os.Open(cStringToGo(path))
+	if err != nil {
+		return jsonError("Failed to open CSV: " + err.Error())
+	}
+	defer file.Close()
+	result, err := purchasingService.ImportPurchasesCSV(file)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(result, "import result")
+}
@@ -0,0 +1,28 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	reportmodel "stockify_backend_golang/src/feature/report/model"
+	reportservice "stockify_backend_golang/src/feature/report/service"
+	"time"
+)
+
+var reportService = reportservice.ReportServiceImplementation(itemRepository, userRepository, noteRepository)
+
+// ========== Report Functions ==========
+
+//export GenerateReport
+func GenerateReport(kind, optionsJSON, outPath *C.char) *C.char {
+	var options reportmodel.ReportOptions
+	if raw := cStringToGo(optionsJSON); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &options); err != nil {
+			return jsonError("Invalid report options: " + err.Error())
+		}
+	}
+	err := reportService.GenerateReport(reportmodel.ReportKind(cStringToGo(kind)), options, cStringToGo(outPath), time.Now())
+	return jsonStatus(err)
+}
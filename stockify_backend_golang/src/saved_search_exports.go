@@ -0,0 +1,102 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	savedsearchmodel "stockify_backend_golang/src/feature/savedsearch/model"
+	savedsearchrepository "stockify_backend_golang/src/feature/savedsearch/repository"
+	savedsearchservice "stockify_backend_golang/src/feature/savedsearch/service"
+)
+
+var savedSearchRepository = savedsearchrepository.SavedSearchRepositoryImplementation()
+var savedSearchService = savedsearchservice.SavedSearchServiceImplementation(savedSearchRepository, itemService)
+
+// ========== Saved Search Functions ==========
+
+// GetSavedSearches returns the saved searches of owner and those shared by
+// colleagues. An empty owner returns every saved search.
+//
+//export GetSavedSearches
+func GetSavedSearches(owner *C.char) *C.char {
+	return jsonResult(savedSearchService.GetSavedSearches(cStringToGo(owner)), "saved searches")
+}
+
+//export GetSavedSearchById
+func GetSavedSearchById(id C.ulonglong) *C.char {
+	search := savedSearchService.GetSavedSearchById(uint64(id))
+	if search.ID == 0 {
+		return jsonError("Saved search not found")
+	}
+	return jsonResult(search, "saved search")
+}
+
+// AddSavedSearch takes a SavedSearch as JSON, its Filter holding the same
+// fields as the params of GetFilteredItemsJSON, and returns the saved search.
+//
+//export AddSavedSearch
+func AddSavedSearch(searchJSON *C.char) *C.char {
+	var search savedsearchmodel.SavedSearch
+	if err := json.Unmarshal([]byte(cStringToGo(searchJSON)), &search); err != nil {
+		return jsonError("Invalid saved search: " + err.Error())
+	}
+	added, err := savedSearchService.AddSavedSearch(search)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(added, "saved search")
+}
+
+//export UpdateSavedSearch
+func UpdateSavedSearch(searchJSON *C.char) *C.char {
+	var search savedsearchmodel.SavedSearch
+	if err := json.Unmarshal([]byte(cStringToGo(searchJSON)), &search); err != nil {
+		return jsonError("Invalid saved search: " + err.Error())
+	}
+	return jsonStatus(savedSearchService.UpdateSavedSearch(search))
+}
+
+//export DeleteSavedSearchById
+func DeleteSavedSearchById(id C.ulonglong) *C.char {
+	return jsonStatus(savedSearchService.DeleteSavedSearchById(uint64(id)))
+}
+
+// RunSavedSearch returns the items the saved search matches now. Reports,
+// labels and audits take the search through the SavedSearchID of their
+// item filter.
+//
+//export RunSavedSearch
+func RunSavedSearch(id C.ulonglong) *C.char {
+	items, err := savedSearchService.RunSavedSearch(uint64(id))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(items, "items")
+}
+
+// ShareSavedSearch returns the saved search as JSON for a colleague to pass
+// to ImportSavedSearch on another database.
+//
+//export ShareSavedSearch
+func ShareSavedSearch(id C.ulonglong) *C.char {
+	shared, err := savedSearchService.ShareSavedSearch(uint64(id))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(shared, "shared search")
+}
+
+//export ImportSavedSearch
+func ImportSavedSearch(sharedJSON, owner *C.char) *C.char {
+	var shared savedsearchmodel.SharedSearch
+	if err := json.Unmarshal([]byte(cStringToGo(sharedJSON)), &shared); err != nil {
+		return jsonError("Invalid shared search: " + err.Error())
+	}
+	imported, err := savedSearchService.ImportSharedSearch(shared, cStringToGo(owner))
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(imported, "saved search")
+}
@@ -0,0 +1,132 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"encoding/json"
+	"errors"
+	"stockify_backend_golang/src/common/event"
+	tagmodel "stockify_backend_golang/src/feature/tag/model"
+	tagrepository "stockify_backend_golang/src/feature/tag/repository"
+	tagservice "stockify_backend_golang/src/feature/tag/service"
+)
+
+var tagRepository = tagrepository.TagRepositoryImplementation()
+var tagService = tagservice.TagServiceImplementation(tagRepository, itemService)
+
+func init() {
+	event.Subscribe(tagService.HandleEvent)
+}
+
+// ========== Tag Functions ==========
+
+//export GetAllTags
+func GetAllTags() *C.char {
+	return jsonResult(tagService.GetAllTags(), "tags")
+}
+
+// AddTag takes a Tag as JSON and returns it with its normalized name
+//
+//export AddTag
+func AddTag(tagJSON *C.char) *C.char {
+	var tag tagmodel.Tag
+	if err := json.Unmarshal([]byte(cStringToGo(tagJSON)), &tag); err != nil {
+		return jsonError("Invalid tag: " + err.Error())
+	}
+	added, err := tagService.AddTag(tag)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(added, "tag")
+}
+
+//export UpdateTag
+func UpdateTag(tagJSON *C.char) *C.char {
+	var tag tagmodel.Tag
+	if err := json.Unmarshal([]byte(cStringToGo(tagJSON)), &tag); err != nil {
+		return jsonError("Invalid tag: " + err.Error())
+	}
+	return jsonStatus(tagService.UpdateTag(tag))
+}
+
+// DeleteTagById deletes the tag and takes it off every item
+//
+//export DeleteTagById
+func DeleteTagById(id C.ulonglong) *C.char {
+	return jsonStatus(tagService.DeleteTagById(uint64(id)))
+}
+
+//export GetItemTags
+func GetItemTags(itemId C.ulonglong) *C.char {
+	return jsonResult(tagService.GetItemTags(uint64(itemId)), "tags")
+}
+
+// SetItemTags replaces the tags of an item with the names in namesJSON, a
+// JSON array of strings. Missing tags are created.
+//
+//export SetItemTags
+func SetItemTags(itemId C.ulonglong, namesJSON *C.char) *C.char {
+	var names []string
+	if err := json.Unmarshal([]byte(cStringToGo(namesJSON)), &names); err != nil {
+		return jsonError("Invalid tag names: " + err.Error())
+	}
+	tags, err := tagService.SetItemTags(uint64(itemId), names)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(tags, "tags")
+}
+
+// TagItems puts the tags named in namesJSON on every item in itemIdsJSON,
+// both JSON arrays. Missing tags are created. Returns the number of tags
+// newly put on items.
+//
+//export TagItems
+func TagItems(itemIdsJSON, namesJSON *C.char) *C.char {
+	itemIds, names, err := decodeBulkTagging(itemIdsJSON, namesJSON)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	added, err := tagService.TagItems(itemIds, names)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(added, "tag count")
+}
+
+// UntagItems takes the tags named in namesJSON off every item in
+// itemIdsJSON. Returns the number of tags taken off items.
+//
+//export UntagItems
+func UntagItems(itemIdsJSON, namesJSON *C.char) *C.char {
+	itemIds, names, err := decodeBulkTagging(itemIdsJSON, namesJSON)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	removed, err := tagService.UntagItems(itemIds, names)
+	if err != nil {
+		return jsonError(err.Error())
+	}
+	return jsonResult(removed, "tag count")
+}
+
+// GetTagCounts returns how many items carry each tag, most used first
+//
+//export GetTagCounts
+func GetTagCounts() *C.char {
+	return jsonResult(tagService.GetTagCounts(), "tag counts")
+}
+
+func decodeBulkTagging(itemIdsJSON, namesJSON *C.char) ([]uint64, []string, error) {
+	var itemIds []uint64
+	if err := json.Unmarshal([]byte(cStringToGo(itemIdsJSON)), &itemIds); err != nil {
+		return nil, nil, errors.New("Invalid item ids: " + err.Error())
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(cStringToGo(namesJSON)), &names); err != nil {
+		return nil, nil, errors.New("Invalid tag names: " + err.Error())
+	}
+	return itemIds, names, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"stockify_backend_golang/src/common/event"
+	webhookmodel "stockify_backend_golang/src/feature/webhook/model"
+	webhookrepository "stockify_backend_golang/src/feature/webhook/repository"
+	webhookservice "stockify_backend_golang/src/feature/webhook/service"
+	"time"
+)
+
+var webhookRepository = webhookrepository.WebhookRepositoryImplementation()
+var webhookService = webhookservice.WebhookServiceImplementation(webhookRepository, nil)
+
+func init() {
+	event.Subscribe(webhookService.HandleEvent)
+}
+
+// ========== Webhook Functions ==========
+
+//export GetAllWebhooks
+func GetAllWebhooks() *C.char {
+	webhooks := webhookService.GetAllWebhooks()
+	// Never hand signing secrets back to the client
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+	return jsonResult(webhooks, "webhooks")
+}
+
+//export AddWebhook
+func AddWebhook(name, url, secret, eventTypes *C.char, enabled C.char) *C.char {
+	webhook := webhookmodel.Webhook{
+		Name:       cStringToGo(name),
+		URL:        cStringToGo(url),
+		Secret:     cStringToGo(secret),
+		EventTypes: cStringToGo(eventTypes),
+		Enabled:    enabled == 1,
+	}
+	return jsonStatus(webhookService.AddWebhook(webhook))
+}
+
+//export UpdateWebhook
+func UpdateWebhook(id C.ulonglong, name, url, secret, eventTypes *C.char, enabled C.char) *C.char {
+	webhook := webhookmodel.Webhook{
+		ID:         uint64(id),
+		Name:       cStringToGo(name),
+		URL:        cStringToGo(url),
+		Secret:     cStringToGo(secret),
+		EventTypes: cStringToGo(eventTypes),
+		Enabled:    enabled == 1,
+	}
+	return jsonStatus(webhookService.UpdateWebhook(webhook))
+}
+
+//export DeleteWebhookById
+func DeleteWebhookById(id C.ulonglong) {
+	webhookService.DeleteWebhookById(uint64(id))
+}
+
+//export GetWebhookDeliveries
+func GetWebhookDeliveries(webhookId C.ulonglong, limit C.int) *C.char {
+	return jsonResult(webhookService.GetDeliveries(uint64(webhookId), int(limit)), "webhook deliveries")
+}
+
+//export RetryWebhookDelivery
+func RetryWebhookDelivery(id C.ulonglong) *C.char {
+	return jsonStatus(webhookService.RetryDelivery(uint64(id)))
+}
+
+//export StartWebhookDispatcher
+func StartWebhookDispatcher(intervalSeconds C.longlong) {
+	interval := time.Duration(intervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	webhookService.StartDispatcher(interval)
+}
+
+//export StopWebhookDispatcher
+func StopWebhookDispatcher() {
+	webhookService.StopDispatcher()
+}